@@ -4,14 +4,17 @@
 package grant
 
 import (
+	"net"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/paths"
 	"storj.io/common/storj"
+	"storj.io/common/testcontext"
 )
 
 func TestLimitTo(t *testing.T) {
@@ -104,3 +107,44 @@ func TestLimitTo(t *testing.T) {
 		}
 	}
 }
+
+func TestRestrict_AllowedNetworksAndMaxObjectSize(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+	apiKey, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	access := &Access{
+		SatelliteAddress: "satellite.test:7777",
+		APIKey:           apiKey,
+		EncAccess:        NewEncryptionAccessWithDefaultKey(&storj.Key{}),
+	}
+
+	_, err = access.Restrict(Permission{AllowUpload: true, AllowedNetworks: []string{"not a network"}})
+	require.Error(t, err)
+
+	_, err = access.Restrict(Permission{AllowUpload: true, MaxObjectSize: -1})
+	require.Error(t, err)
+
+	restricted, err := access.Restrict(Permission{
+		AllowUpload:     true,
+		AllowedNetworks: []string{"192.0.2.0/24"},
+		MaxObjectSize:   1024,
+	})
+	require.NoError(t, err)
+
+	check := func(ip string, size int64) error {
+		return restricted.APIKey.Check(ctx, secret, macaroon.Action{
+			Op:         macaroon.ActionWrite,
+			Time:       time.Now(),
+			ClientIP:   net.ParseIP(ip),
+			ObjectSize: size,
+		}, nil)
+	}
+
+	require.NoError(t, check("192.0.2.1", 1024))
+	require.Error(t, check("198.51.100.1", 1024))
+	require.Error(t, check("192.0.2.1", 1025))
+}
@@ -51,6 +51,21 @@ type Permission struct {
 	// believes the time is after NotAfter.
 	// If set, this value should always be after NotBefore.
 	NotAfter time.Time
+	// AllowedNetworks restricts the client addresses the resulting access
+	// grant can be used from, given in CIDR notation (e.g. 192.0.2.0/24).
+	// If empty, any address is allowed.
+	AllowedNetworks []string
+	// MaxObjectSize restricts the size of uploaded objects in bytes.
+	// If zero, the size is not restricted.
+	MaxObjectSize int64
+}
+
+// isEmpty returns whether the permission doesn't grant or restrict anything.
+func (permission Permission) isEmpty() bool {
+	return !permission.AllowDownload && !permission.AllowUpload &&
+		!permission.AllowList && !permission.AllowDelete &&
+		permission.NotBefore.IsZero() && permission.NotAfter.IsZero() &&
+		len(permission.AllowedNetworks) == 0 && permission.MaxObjectSize == 0
 }
 
 // Restrict creates a new access grant with specific permissions.
@@ -62,7 +77,7 @@ type Permission struct {
 // Prefixes, if provided, restrict the access grant (and internal encryption information)
 // to only contain enough information to allow access to just those prefixes.
 func (access *Access) Restrict(permission Permission, prefixes ...SharePrefix) (*Access, error) {
-	if permission == (Permission{}) {
+	if permission.isEmpty() {
 		return nil, errors.New("permission is empty")
 	}
 
@@ -78,6 +93,10 @@ func (access *Access) Restrict(permission Permission, prefixes ...SharePrefix) (
 		return nil, errors.New("invalid time range")
 	}
 
+	if permission.MaxObjectSize < 0 {
+		return nil, errors.New("invalid max object size")
+	}
+
 	caveat := macaroon.WithNonce(macaroon.Caveat{
 		DisallowReads:   !permission.AllowDownload,
 		DisallowWrites:  !permission.AllowUpload,
@@ -85,8 +104,14 @@ func (access *Access) Restrict(permission Permission, prefixes ...SharePrefix) (
 		DisallowDeletes: !permission.AllowDelete,
 		NotBefore:       notBefore,
 		NotAfter:        notAfter,
+		MaxObjectSize:   permission.MaxObjectSize,
 	})
 
+	caveat, err := macaroon.WithAllowedNetworks(caveat, permission.AllowedNetworks...)
+	if err != nil {
+		return nil, err
+	}
+
 	encAccess := NewEncryptionAccess()
 	encAccess.SetDefaultPathCipher(access.EncAccess.Store.GetDefaultPathCipher())
 	if len(prefixes) == 0 {
@@ -56,12 +56,16 @@ type CASetupConfig struct {
 	Timeout        string `help:"timeout for CA generation; golang duration string (0 no timeout)" default:"5m"`
 	Overwrite      bool   `help:"if true, existing CA certs AND keys will overwritten" default:"false" setup:"true"`
 	Concurrency    uint   `help:"number of concurrent workers for certificate authority generation" default:"4"`
+
+	KeyType pkcrypto.KeyType `help:"type of the private key to generate (ecdsa or ed25519)" default:"ecdsa"`
 }
 
 // NewCAOptions is used to pass parameters to `NewCA`.
 type NewCAOptions struct {
 	// VersionNumber is the IDVersion to use for the identity
 	VersionNumber storj.IDVersionNumber
+	// KeyType overrides the private key type of the IDVersion, when set
+	KeyType pkcrypto.KeyType
 	// Difficulty is the number of trailing zero-bits the nodeID must have
 	Difficulty uint16
 	// Concurrency is the number of go routines used to generate a CA of sufficient difficulty
@@ -109,6 +113,9 @@ func NewCA(ctx context.Context, opts NewCAOptions) (_ *FullCertificateAuthority,
 	if err != nil {
 		return nil, err
 	}
+	if opts.KeyType != "" {
+		version.NewPrivateKey = opts.KeyType.GeneratePrivateKey
+	}
 
 	updateStatus := func() {
 		if opts.Logger != nil {
@@ -228,6 +235,7 @@ func (caS CASetupConfig) Create(ctx context.Context, logger io.Writer) (*FullCer
 		VersionNumber: version.Number,
 		Difficulty:    uint16(caS.Difficulty),
 		Concurrency:   caS.Concurrency,
+		KeyType:       caS.KeyType,
 		ParentCert:    parent.Cert,
 		ParentKey:     parent.Key,
 		Logger:        logger,
@@ -386,7 +394,12 @@ func (ca *FullCertificateAuthority) NewIdentity(exts ...pkix.Extension) (*FullId
 	if err != nil {
 		return nil, err
 	}
-	leafKey, err := version.NewPrivateKey()
+	// the leaf uses the same key type as the CA, when it's known.
+	newPrivateKey := version.NewPrivateKey
+	if keyType, err := pkcrypto.KeyTypeOf(ca.Key); err == nil {
+		newPrivateKey = keyType.GeneratePrivateKey
+	}
+	leafKey, err := newPrivateKey()
 	if err != nil {
 		return nil, err
 	}
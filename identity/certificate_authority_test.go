@@ -5,6 +5,7 @@ package identity_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"fmt"
@@ -15,8 +16,10 @@ import (
 
 	"storj.io/common/identity"
 	"storj.io/common/identity/testidentity"
+	"storj.io/common/peertls"
 	"storj.io/common/peertls/extensions"
 	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/pkcrypto"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
@@ -69,6 +72,43 @@ func TestFullCertificateAuthority_NewIdentity(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFullCertificateAuthority_NewIdentity_Ed25519(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ca, err := identity.NewCA(ctx, identity.NewCAOptions{
+		Difficulty:  4,
+		Concurrency: 4,
+		KeyType:     pkcrypto.KeyTypeEd25519,
+	})
+	require.NoError(t, err)
+	require.IsType(t, ed25519.PrivateKey{}, ca.Key)
+
+	nodeID, err := identity.NodeIDFromCert(ca.Cert)
+	require.NoError(t, err)
+	assert.Equal(t, ca.ID, nodeID)
+
+	fi, err := ca.NewIdentity()
+	require.NoError(t, err)
+	require.IsType(t, ed25519.PrivateKey{}, fi.Key)
+
+	err = fi.Leaf.CheckSignatureFrom(ca.Cert)
+	assert.NoError(t, err)
+
+	err = peertls.VerifyPeerFunc(peertls.VerifyPeerCertChains)([][]byte{fi.Leaf.Raw, fi.CA.Raw}, nil)
+	assert.NoError(t, err)
+
+	// the identity must round-trip through PEM
+	chainPEM, err := peertls.ChainBytes(fi.Chain()...)
+	require.NoError(t, err)
+	keyPEM, err := pkcrypto.PrivateKeyToPEM(fi.Key)
+	require.NoError(t, err)
+
+	loaded, err := identity.FullIdentityFromPEM(chainPEM, keyPEM)
+	require.NoError(t, err)
+	assert.Equal(t, fi.ID, loaded.ID)
+	assert.Equal(t, fi.Key, loaded.Key)
+}
+
 func TestFullCertificateAuthority_Sign(t *testing.T) {
 	ctx := testcontext.New(t)
 
@@ -6,6 +6,15 @@ package identity
 import (
 	"context"
 	"crypto"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
 
 	"storj.io/common/pkcrypto"
 	"storj.io/common/storj"
@@ -16,13 +25,22 @@ import (
 func GenerateKey(ctx context.Context, minDifficulty uint16, version storj.IDVersion) (
 	k crypto.PrivateKey, id storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return generateKey(ctx, minDifficulty, version, nil)
+}
 
+// generateKey is GenerateKey which additionally increments attempts, when
+// not nil, for every candidate key it tries.
+func generateKey(ctx context.Context, minDifficulty uint16, version storj.IDVersion, attempts *uint64) (
+	k crypto.PrivateKey, id storj.NodeID, err error) {
 	var d uint16
 	for {
 		err = ctx.Err()
 		if err != nil {
 			break
 		}
+		if attempts != nil {
+			atomic.AddUint64(attempts, 1)
+		}
 		k, err = version.NewPrivateKey()
 		if err != nil {
 			break
@@ -87,3 +105,275 @@ func GenerateKeys(ctx context.Context, minDifficulty uint16, concurrency int, ve
 	// context cancellation errors
 	return <-errchan
 }
+
+// GenerateProgress is the resumable state of a GenerateBatch run.
+type GenerateProgress struct {
+	// Attempts is the number of candidate keys tried so far.
+	Attempts uint64 `json:"attempts"`
+	// Elapsed is the total time spent generating so far.
+	Elapsed time.Duration `json:"elapsed"`
+	// Completed contains the indexes of the targets that have been found.
+	Completed []int `json:"completed"`
+	// Difficulties contains the targets of the run, which a resumed run must match.
+	Difficulties []uint16 `json:"difficulties"`
+}
+
+// KeysPerSecond returns the average generation rate.
+func (progress GenerateProgress) KeysPerSecond() float64 {
+	if progress.Elapsed <= 0 {
+		return 0
+	}
+	return float64(progress.Attempts) / progress.Elapsed.Seconds()
+}
+
+// completed returns whether target i has been found.
+func (progress GenerateProgress) completed(i int) bool {
+	for _, c := range progress.Completed {
+		if c == i {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns whether the progress was made for the difficulties.
+func (progress GenerateProgress) matches(difficulties []uint16) bool {
+	if len(progress.Difficulties) != len(difficulties) {
+		return false
+	}
+	for i, difficulty := range difficulties {
+		if progress.Difficulties[i] != difficulty {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadGenerateProgress loads a checkpoint written by GenerateBatch.
+// A missing file results in empty progress.
+func LoadGenerateProgress(path string) (progress GenerateProgress, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return GenerateProgress{}, nil
+		}
+		return GenerateProgress{}, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return GenerateProgress{}, Error.New("invalid checkpoint %q: %v", path, err)
+	}
+	return progress, nil
+}
+
+// save atomically writes the progress to path.
+func (progress GenerateProgress) save(path string) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	tmp := path + ".tmp"
+	if err := writeFile(tmp, 0700, 0600, data); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp, path))
+}
+
+// GenerateBatchConfig configures GenerateBatch.
+type GenerateBatchConfig struct {
+	// Difficulties contains the minimum difficulty of every identity to generate.
+	Difficulties []uint16
+	// Concurrency is the number of parallel workers.
+	Concurrency int
+	// Version is the identity version of the generated keys.
+	// The latest version is used when it's not set.
+	Version storj.IDVersion
+
+	// Checkpoint is the path where progress is saved. When it already
+	// exists the run is resumed from it, which requires the same Difficulties.
+	// No checkpointing is done when empty.
+	Checkpoint string
+	// ReportInterval is how often Report is called and the checkpoint saved.
+	// The run fails if the checkpoint can't be saved.
+	ReportInterval time.Duration
+	// Report, when not nil, is called periodically with the current progress.
+	Report func(GenerateProgress)
+}
+
+// GenerateBatchCallback is called with every found key and the index of the
+// target in GenerateBatchConfig.Difficulties that it satisfies. The callback
+// should persist the key before returning, because the target is considered
+// completed afterwards.
+type GenerateBatchCallback func(target int, k crypto.PrivateKey, id storj.NodeID) error
+
+// GenerateBatch generates keys for every target in config.Difficulties,
+// checkpointing the progress so that an interrupted run can be resumed.
+// A found key is assigned to the most difficult pending target it satisfies.
+func GenerateBatch(ctx context.Context, config GenerateBatchConfig, found GenerateBatchCallback) (_ GenerateProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	if config.Version.NewPrivateKey == nil {
+		config.Version = storj.LatestIDVersion()
+	}
+
+	var progress GenerateProgress
+	if config.Checkpoint != "" {
+		progress, err = LoadGenerateProgress(config.Checkpoint)
+		if err != nil {
+			return progress, err
+		}
+	}
+	switch {
+	case progress.Difficulties == nil && progress.Attempts == 0 && len(progress.Completed) == 0:
+		progress.Difficulties = append([]uint16(nil), config.Difficulties...)
+	case !progress.matches(config.Difficulties):
+		return GenerateProgress{}, Error.New("checkpoint %q was created for different difficulties", config.Checkpoint)
+	}
+
+	var pending []int
+	for i := range config.Difficulties {
+		if !progress.completed(i) {
+			pending = append(pending, i)
+		}
+	}
+	// most difficult targets first.
+	sort.SliceStable(pending, func(a, b int) bool {
+		return config.Difficulties[pending[a]] > config.Difficulties[pending[b]]
+	})
+	if len(pending) == 0 {
+		return progress, nil
+	}
+
+	var mu sync.Mutex
+	attempts := progress.Attempts
+	elapsed := progress.Elapsed
+	start := time.Now()
+
+	// snapshot must be called with mu held.
+	snapshot := func() GenerateProgress {
+		snap := progress
+		snap.Attempts = atomic.LoadUint64(&attempts)
+		snap.Elapsed = elapsed + time.Since(start)
+		snap.Completed = append([]int(nil), progress.Completed...)
+		snap.Difficulties = append([]uint16(nil), progress.Difficulties...)
+		return snap
+	}
+	checkpoint := func() error {
+		snap := snapshot()
+		if config.Report != nil {
+			config.Report(snap)
+		}
+		if config.Checkpoint != "" {
+			return snap.save(config.Checkpoint)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// one more slot for the checkpointing goroutine.
+	errchan := make(chan error, config.Concurrency+1)
+
+	var wg sync.WaitGroup
+	if config.ReportInterval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(config.ReportInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					mu.Lock()
+					err := checkpoint()
+					mu.Unlock()
+					if err != nil {
+						errchan <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	// minimum difficulty still needed, must be accessed with mu held.
+	minDifficulty := func() uint16 {
+		return config.Difficulties[pending[len(pending)-1]]
+	}
+
+	for i := 0; i < config.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if len(pending) == 0 {
+					mu.Unlock()
+					errchan <- nil
+					return
+				}
+				difficulty := minDifficulty()
+				mu.Unlock()
+
+				k, id, err := generateKey(ctx, difficulty, config.Version, &attempts)
+				if err != nil {
+					errchan <- err
+					return
+				}
+				d, err := id.Difficulty()
+				if err != nil {
+					errchan <- err
+					return
+				}
+
+				mu.Lock()
+				if ctx.Err() != nil {
+					mu.Unlock()
+					errchan <- ctx.Err()
+					return
+				}
+				for j, target := range pending {
+					if d < config.Difficulties[target] {
+						continue
+					}
+					if err := found(target, k, id); err != nil {
+						mu.Unlock()
+						errchan <- err
+						return
+					}
+					pending = append(pending[:j], pending[j+1:]...)
+					progress.Completed = append(progress.Completed, target)
+					if err := checkpoint(); err != nil {
+						mu.Unlock()
+						errchan <- err
+						return
+					}
+					break
+				}
+				done := len(pending) == 0
+				mu.Unlock()
+
+				if done {
+					errchan <- nil
+					return
+				}
+			}
+		}()
+	}
+
+	// we only care about the first result. the rest of the errors will be
+	// context cancellation errors
+	err = <-errchan
+	cancel()
+	wg.Wait()
+
+	if err != nil && config.Checkpoint != "" {
+		err = errs.Combine(err, snapshot().save(config.Checkpoint))
+	}
+	return snapshot(), err
+}
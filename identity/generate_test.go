@@ -0,0 +1,135 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package identity_test
+
+import (
+	"context"
+	"crypto"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+)
+
+func TestGenerateBatch(t *testing.T) {
+	ctx := testcontext.New(t)
+	checkpoint := filepath.Join(ctx.Dir("generate"), "checkpoint.json")
+
+	config := identity.GenerateBatchConfig{
+		Difficulties: []uint16{2, 4, 0},
+		Concurrency:  2,
+		Version:      storj.LatestIDVersion(),
+		Checkpoint:   checkpoint,
+	}
+
+	var mu sync.Mutex
+	found := map[int]storj.NodeID{}
+	progress, err := identity.GenerateBatch(ctx, config, func(target int, k crypto.PrivateKey, id storj.NodeID) error {
+		mu.Lock()
+		defer mu.Unlock()
+		found[target] = id
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, found, 3)
+	require.ElementsMatch(t, []int{0, 1, 2}, progress.Completed)
+	require.NotZero(t, progress.Attempts)
+
+	for target, id := range found {
+		difficulty, err := id.Difficulty()
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, difficulty, config.Difficulties[target])
+	}
+
+	saved, err := identity.LoadGenerateProgress(checkpoint)
+	require.NoError(t, err)
+	require.ElementsMatch(t, progress.Completed, saved.Completed)
+	require.Equal(t, config.Difficulties, saved.Difficulties)
+
+	// resuming a finished run doesn't generate anything.
+	resumed, err := identity.GenerateBatch(ctx, config, func(int, crypto.PrivateKey, storj.NodeID) error {
+		t.Fatal("unexpected key")
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, saved.Attempts, resumed.Attempts)
+}
+
+func TestGenerateBatch_Resume(t *testing.T) {
+	ctx := testcontext.New(t)
+	checkpoint := filepath.Join(ctx.Dir("generate"), "checkpoint.json")
+
+	config := identity.GenerateBatchConfig{
+		Difficulties: []uint16{0, 255},
+		Version:      storj.LatestIDVersion(),
+		Checkpoint:   checkpoint,
+	}
+
+	// the second target is unreachable, so interrupt the run once the
+	// first one has been found.
+	cctx, cancel := context.WithCancel(ctx)
+	progress, err := identity.GenerateBatch(cctx, config, func(target int, k crypto.PrivateKey, id storj.NodeID) error {
+		require.Equal(t, 0, target)
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{0}, progress.Completed)
+
+	saved, err := identity.LoadGenerateProgress(checkpoint)
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, saved.Completed)
+	require.Equal(t, progress.Attempts, saved.Attempts)
+
+	// the resumed run continues counting from the checkpoint.
+	tctx, tcancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer tcancel()
+	resumed, err := identity.GenerateBatch(tctx, config, func(target int, k crypto.PrivateKey, id storj.NodeID) error {
+		t.Fatal("unexpected key")
+		return nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, []int{0}, resumed.Completed)
+	require.Greater(t, resumed.Attempts, saved.Attempts)
+	require.GreaterOrEqual(t, resumed.Elapsed, saved.Elapsed)
+
+	// a checkpoint can't be resumed with different targets.
+	config.Difficulties[1] = 0
+	_, err = identity.GenerateBatch(ctx, config, func(target int, k crypto.PrivateKey, id storj.NodeID) error {
+		t.Fatal("unexpected key")
+		return nil
+	})
+	require.Error(t, err)
+}
+
+func TestGenerateBatch_DefaultVersion(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var found storj.NodeID
+	progress, err := identity.GenerateBatch(ctx, identity.GenerateBatchConfig{
+		Difficulties: []uint16{0},
+	}, func(target int, k crypto.PrivateKey, id storj.NodeID) error {
+		found = id
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, progress.Completed)
+	require.Equal(t, storj.LatestIDVersion().Number, found.Version().Number)
+}
+
+func TestLoadGenerateProgress_Missing(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	progress, err := identity.LoadGenerateProgress(filepath.Join(ctx.Dir(), "missing.json"))
+	require.NoError(t, err)
+	require.Zero(t, progress.Attempts)
+	require.Empty(t, progress.Completed)
+	require.Zero(t, progress.KeysPerSecond())
+}
@@ -6,6 +6,7 @@ package macaroon
 import (
 	"bytes"
 	"context"
+	"net"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -61,6 +62,16 @@ type Action struct {
 	Bucket        []byte
 	EncryptedPath []byte
 	Time          time.Time
+
+	// ClientIP is the address of the client performing the action. It's
+	// required when the API key is restricted to some networks.
+	ClientIP net.IP
+	// ObjectSize is the size of the object being written, zero if unknown.
+	// Writes of an unknown size are allowed by MaxObjectSize caveats, so they
+	// are only enforced when the size is passed in, e.g. the committed or
+	// cumulative size at CommitSegment and CommitObject, as the size isn't
+	// known at BeginObject.
+	ObjectSize int64
 }
 
 // APIKey implements a Macaroon-backed Storj-v3 API key.
@@ -213,6 +224,23 @@ func (a *APIKey) GetAllowedBuckets(ctx context.Context, action Action) (allowed
 	return allowed, err
 }
 
+// NotAfter returns the earliest expiration of all the caveats, or nil
+// when the API key doesn't expire.
+func (a *APIKey) NotAfter() (*time.Time, error) {
+	var notAfter *time.Time
+	for _, cavbuf := range a.mac.Caveats() {
+		var cav Caveat
+		err := pb.Unmarshal(cavbuf, &cav)
+		if err != nil {
+			return nil, ErrFormat.New("invalid caveat format: %v", err)
+		}
+		if cav.NotAfter != nil && (notAfter == nil || cav.NotAfter.Before(*notAfter)) {
+			notAfter = cav.NotAfter
+		}
+	}
+	return notAfter, nil
+}
+
 // Restrict generates a new APIKey with the provided Caveat attached.
 func (a *APIKey) Restrict(caveat Caveat) (*APIKey, error) {
 	buf, err := pb.Marshal(&caveat)
@@ -258,6 +286,10 @@ func (c *Caveat) Allows(action Action) bool {
 		return false
 	}
 
+	if len(c.AllowedNetworks) > 0 && !networksContain(c.AllowedNetworks, action.ClientIP) {
+		return false
+	}
+
 	// we want to always allow reads for bucket metadata, perhaps filtered by the
 	// buckets in the allowed paths.
 	if action.Op == ActionRead && len(action.EncryptedPath) == 0 {
@@ -287,6 +319,9 @@ func (c *Caveat) Allows(action Action) bool {
 		if c.DisallowWrites {
 			return false
 		}
+		if c.MaxObjectSize > 0 && action.ObjectSize > c.MaxObjectSize {
+			return false
+		}
 	case ActionList:
 		if c.DisallowLists {
 			return false
@@ -317,3 +352,21 @@ func (c *Caveat) Allows(action Action) bool {
 
 	return true
 }
+
+// networksContain returns whether ip is in any of the networks. Networks
+// which can't be parsed don't contain any address.
+func networksContain(networks []string, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range networks {
+		_, ipnet, err := net.ParseCIDR(network)
+		if err != nil {
+			continue
+		}
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -151,6 +152,110 @@ func TestExpiration(t *testing.T) {
 	}
 }
 
+func TestAllowedNetworks(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	key, err := NewAPIKey(secret)
+	require.NoError(t, err)
+
+	_, err = WithAllowedNetworks(Caveat{}, "not a network")
+	require.Error(t, err)
+
+	caveat, err := WithAllowedNetworks(Caveat{}, "192.0.2.0/24", "2001:db8::/32")
+	require.NoError(t, err)
+	restricted, err := key.Restrict(caveat)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		ip      net.IP
+		allowed bool
+	}{
+		{net.ParseIP("192.0.2.10"), true},
+		{net.ParseIP("2001:db8::1"), true},
+		{net.ParseIP("198.51.100.1"), false},
+		{nil, false},
+	} {
+		action := Action{Op: ActionRead, Time: time.Now(), ClientIP: test.ip}
+		require.NoError(t, key.Check(ctx, secret, action, nil))
+
+		err := restricted.Check(ctx, secret, action, nil)
+		if test.allowed {
+			require.NoError(t, err, test.ip)
+		} else {
+			require.True(t, ErrUnauthorized.Has(err), test.ip)
+		}
+	}
+}
+
+func TestMaxObjectSize(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	key, err := NewAPIKey(secret)
+	require.NoError(t, err)
+
+	restricted, err := key.Restrict(Caveat{MaxObjectSize: 1024})
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		op      ActionType
+		size    int64
+		allowed bool
+	}{
+		// the size isn't known when an upload begins, so it's allowed.
+		{ActionWrite, 0, true},
+		{ActionWrite, 1024, true},
+		{ActionWrite, 1025, false},
+		{ActionRead, 1025, true},
+	} {
+		action := Action{Op: test.op, Time: time.Now(), ObjectSize: test.size}
+		err := restricted.Check(ctx, secret, action, nil)
+		if test.allowed {
+			require.NoError(t, err, test)
+		} else {
+			require.True(t, ErrUnauthorized.Has(err), test)
+		}
+	}
+}
+
+func TestNotAfter(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	key, err := NewAPIKey(secret)
+	require.NoError(t, err)
+
+	notAfter, err := key.NotAfter()
+	require.NoError(t, err)
+	require.Nil(t, notAfter)
+
+	now := time.Now()
+	hourFromNow := now.Add(time.Hour)
+	minuteFromNow := now.Add(time.Minute)
+
+	restricted, err := key.Restrict(Caveat{NotAfter: &hourFromNow})
+	require.NoError(t, err)
+	restricted, err = restricted.Restrict(Caveat{DisallowWrites: true})
+	require.NoError(t, err)
+
+	notAfter, err = restricted.NotAfter()
+	require.NoError(t, err)
+	require.NotNil(t, notAfter)
+	require.True(t, notAfter.Equal(hourFromNow))
+
+	// a later restriction can only shorten the expiration.
+	restricted, err = restricted.Restrict(Caveat{NotAfter: &minuteFromNow})
+	require.NoError(t, err)
+	restricted, err = restricted.Restrict(Caveat{NotAfter: &hourFromNow})
+	require.NoError(t, err)
+
+	notAfter, err = restricted.NotAfter()
+	require.NoError(t, err)
+	require.True(t, notAfter.Equal(minuteFromNow))
+}
+
 func TestGetAllowedBuckets(t *testing.T) {
 	ctx := context.Background()
 
@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	mrand "math/rand"
+	"net"
 	"time"
 
 	"storj.io/common/encryption"
@@ -29,6 +30,19 @@ func WithNonce(in Caveat) Caveat {
 	return in
 }
 
+// WithAllowedNetworks returns a Caveat which additionally restricts the client
+// address to the networks, given in CIDR notation.
+// Note: This does a shallow copy the provided Caveat.
+func WithAllowedNetworks(in Caveat, networks ...string) (Caveat, error) {
+	for _, network := range networks {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return Caveat{}, Error.Wrap(err)
+		}
+	}
+	in.AllowedNetworks = append(append([]string(nil), in.AllowedNetworks...), networks...)
+	return in, nil
+}
+
 type caveatPathMarshal struct {
 	Bucket              string `json:"bucket,omitempty"`
 	EncryptedPathPrefix string `json:"encrypted_path_prefix,omitempty"`
@@ -37,7 +37,14 @@ type Caveat struct {
 	NotBefore *time.Time `protobuf:"bytes,21,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 	// nonce is set to some random bytes so that you can make arbitrarily
 	// many restricted macaroons with the same (or no) restrictions.
-	Nonce                []byte   `protobuf:"bytes,30,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Nonce []byte `protobuf:"bytes,30,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// If any entries exist, require the client address to be in at least
+	// one of these networks, in CIDR notation (e.g. 192.0.2.0/24).
+	AllowedNetworks []string `protobuf:"bytes,40,rep,name=allowed_networks,json=allowedNetworks,proto3" json:"allowed_networks,omitempty"`
+	// if set, the maximum size of uploaded objects in bytes. The size isn't
+	// known when an upload begins, so it's only enforced when the satellite
+	// checks the committed or cumulative size at CommitSegment and CommitObject.
+	MaxObjectSize        int64    `protobuf:"varint,41,opt,name=max_object_size,json=maxObjectSize,proto3" json:"max_object_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -123,6 +130,20 @@ func (m *Caveat) GetNonce() []byte {
 	return nil
 }
 
+func (m *Caveat) GetAllowedNetworks() []string {
+	if m != nil {
+		return m.AllowedNetworks
+	}
+	return nil
+}
+
+func (m *Caveat) GetMaxObjectSize() int64 {
+	if m != nil {
+		return m.MaxObjectSize
+	}
+	return 0
+}
+
 // If any entries exist, require all access to happen in at least
 // one of them.
 type Caveat_Path struct {
@@ -179,27 +200,30 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x50, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x25, 0xaf, 0x7d, 0xa5, 0xef, 0x36, 0x7d, 0xef, 0x31, 0xb6, 0x12, 0xb2, 0xb0, 0x41, 0x10,
-	0xe3, 0x66, 0x0a, 0x75, 0x27, 0x88, 0x58, 0x5d, 0xba, 0x28, 0x83, 0xe0, 0x32, 0x4c, 0x92, 0x9b,
-	0x34, 0x98, 0x66, 0xc2, 0xcc, 0xd4, 0xda, 0xbf, 0xf0, 0xd3, 0xfc, 0x03, 0x7f, 0x45, 0x66, 0xd2,
-	0x04, 0xba, 0x73, 0x79, 0xce, 0x3d, 0xe7, 0xdc, 0x7b, 0x0f, 0x8c, 0xf4, 0xbe, 0x46, 0x45, 0x6b,
-	0x29, 0xb4, 0x20, 0xc3, 0x0d, 0x4f, 0xb8, 0x14, 0xa2, 0xf2, 0x21, 0x17, 0xb9, 0x68, 0x58, 0x7f,
-	0x96, 0x0b, 0x91, 0x97, 0x38, 0xb7, 0x28, 0xde, 0x66, 0x73, 0x5d, 0x6c, 0x50, 0x69, 0xbe, 0xa9,
-	0x1b, 0xc1, 0xf9, 0x67, 0x0f, 0x06, 0x0f, 0xfc, 0x0d, 0xb9, 0x26, 0x17, 0xf0, 0x37, 0x2d, 0x14,
-	0x2f, 0x4b, 0xb1, 0x8b, 0x24, 0xf2, 0x54, 0x79, 0x4e, 0xe0, 0x84, 0x43, 0x36, 0x6e, 0x59, 0x66,
-	0x48, 0x72, 0x09, 0xff, 0x3a, 0xd9, 0x4e, 0x16, 0x1a, 0x95, 0xf7, 0xcb, 0xea, 0x3a, 0xf7, 0x8b,
-	0x65, 0x8f, 0xf2, 0xca, 0x42, 0x69, 0xe5, 0xf5, 0x8e, 0xf3, 0x9e, 0x0c, 0x49, 0xae, 0xe0, 0x7f,
-	0x27, 0x4b, 0xb1, 0x44, 0x13, 0xd8, 0xb7, 0xc2, 0x6e, 0xcf, 0x63, 0x43, 0x93, 0x1b, 0x18, 0x5b,
-	0x8c, 0x69, 0x54, 0x73, 0xbd, 0x56, 0x1e, 0x04, 0xbd, 0x70, 0xb4, 0x98, 0xd2, 0xf6, 0x77, 0xda,
-	0xbc, 0x42, 0x57, 0x5c, 0xaf, 0x99, 0x7b, 0xd0, 0x1a, 0xa0, 0xc8, 0x2d, 0xfc, 0xa9, 0x84, 0x8e,
-	0x78, 0xa6, 0x51, 0x7a, 0x93, 0xc0, 0x09, 0x47, 0x0b, 0x9f, 0x36, 0xed, 0xd0, 0xb6, 0x1d, 0xfa,
-	0xdc, 0xb6, 0xb3, 0xec, 0x7f, 0x7c, 0xcd, 0x1c, 0x36, 0xac, 0x84, 0xbe, 0x37, 0x0e, 0x72, 0x07,
-	0x60, 0xec, 0x31, 0x66, 0x42, 0xa2, 0x37, 0xfd, 0xa1, 0xdf, 0xac, 0x5c, 0x5a, 0x0b, 0x99, 0xc0,
-	0xef, 0x4a, 0x54, 0x09, 0x7a, 0x67, 0x81, 0x13, 0xba, 0xac, 0x01, 0x3e, 0x83, 0xbe, 0x39, 0x8f,
-	0x9c, 0xc2, 0x20, 0xde, 0x26, 0xaf, 0xa8, 0x6d, 0xe7, 0x2e, 0x3b, 0x20, 0xb2, 0x80, 0x29, 0x56,
-	0x89, 0xdc, 0xd7, 0xfa, 0xf0, 0x73, 0x54, 0x4b, 0xcc, 0x8a, 0x77, 0x5b, 0xb9, 0xcb, 0x4e, 0xba,
-	0xa1, 0x49, 0x59, 0xd9, 0x51, 0x3c, 0xb0, 0xe7, 0x5c, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xca,
-	0x7b, 0x7d, 0xfc, 0x1f, 0x02, 0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x65, 0x12, 0xa2, 0x74, 0xe2, 0x10, 0xb4, 0x34, 0x68, 0xe5, 0x03, 0xb5, 0x90, 0x00,
+	0xf7, 0xe2, 0x4a, 0xe1, 0x86, 0x84, 0x10, 0x85, 0x23, 0x82, 0x6a, 0x41, 0xe2, 0x68, 0xad, 0xed,
+	0xb1, 0x6b, 0x6a, 0x7b, 0xad, 0xdd, 0x29, 0x49, 0xfb, 0x14, 0xbc, 0x03, 0x0f, 0xc6, 0xab, 0xa0,
+	0x5d, 0xff, 0x91, 0x72, 0xeb, 0xf1, 0xfb, 0xed, 0xf7, 0xcd, 0xea, 0x9b, 0x81, 0x15, 0xdd, 0x75,
+	0x68, 0xe2, 0x4e, 0x2b, 0x52, 0x6c, 0xd9, 0xc8, 0x4c, 0x6a, 0xa5, 0xda, 0x00, 0x4a, 0x55, 0xaa,
+	0x9e, 0x06, 0x67, 0xa5, 0x52, 0x65, 0x8d, 0x17, 0x4e, 0xa5, 0xb7, 0xc5, 0x05, 0x55, 0x0d, 0x1a,
+	0x92, 0x4d, 0xd7, 0x1b, 0x5e, 0xfe, 0x9d, 0xc3, 0xe2, 0x93, 0xfc, 0x8d, 0x92, 0xd8, 0x2b, 0x78,
+	0x92, 0x57, 0x46, 0xd6, 0xb5, 0xda, 0x27, 0x1a, 0x65, 0x6e, 0xb8, 0x17, 0x7a, 0xd1, 0x52, 0xac,
+	0x47, 0x2a, 0x2c, 0x64, 0x6f, 0x60, 0x33, 0xd9, 0xf6, 0xba, 0x22, 0x34, 0xfc, 0x91, 0xf3, 0x4d,
+	0xe9, 0x9f, 0x8e, 0x1e, 0xcd, 0xab, 0x2b, 0x43, 0x86, 0xcf, 0x8e, 0xe7, 0x7d, 0xb1, 0x90, 0x9d,
+	0xc3, 0xd3, 0xc9, 0x96, 0x63, 0x8d, 0x76, 0xe0, 0xdc, 0x19, 0xa7, 0x7f, 0x3e, 0xf7, 0x98, 0xbd,
+	0x83, 0xb5, 0xd3, 0x98, 0x27, 0x9d, 0xa4, 0x6b, 0xc3, 0x21, 0x9c, 0x45, 0xab, 0xdd, 0x36, 0x1e,
+	0xbb, 0xc7, 0x7d, 0x95, 0xf8, 0x4a, 0xd2, 0xb5, 0xf0, 0x07, 0xaf, 0x15, 0x86, 0xbd, 0x87, 0x93,
+	0x56, 0x51, 0x22, 0x0b, 0x42, 0xcd, 0x4f, 0x43, 0x2f, 0x5a, 0xed, 0x82, 0xb8, 0xdf, 0x4e, 0x3c,
+	0x6e, 0x27, 0xfe, 0x31, 0x6e, 0xe7, 0x72, 0xfe, 0xe7, 0xdf, 0x99, 0x27, 0x96, 0xad, 0xa2, 0x8f,
+	0x36, 0xc1, 0x3e, 0x00, 0xd8, 0x78, 0x8a, 0x85, 0xd2, 0xc8, 0xb7, 0x0f, 0xcc, 0xdb, 0x2f, 0x2f,
+	0x5d, 0x84, 0x9d, 0xc2, 0xe3, 0x56, 0xb5, 0x19, 0xf2, 0x17, 0xa1, 0x17, 0xf9, 0xa2, 0x17, 0xb6,
+	0xfc, 0xd8, 0xa8, 0x45, 0xda, 0x2b, 0x7d, 0x63, 0x78, 0x14, 0xce, 0xa2, 0x13, 0xb1, 0x19, 0xf8,
+	0xd7, 0x01, 0xb3, 0xd7, 0xb0, 0x69, 0xe4, 0x21, 0x51, 0xe9, 0x2f, 0xcc, 0x28, 0x31, 0xd5, 0x3d,
+	0xf2, 0xf3, 0xd0, 0x8b, 0x66, 0x62, 0xdd, 0xc8, 0xc3, 0x37, 0x47, 0xbf, 0x57, 0xf7, 0x18, 0x08,
+	0x98, 0xdb, 0xc6, 0xec, 0x39, 0x2c, 0xd2, 0xdb, 0xec, 0x06, 0xc9, 0x9d, 0xd1, 0x17, 0x83, 0x62,
+	0x3b, 0xd8, 0x62, 0x9b, 0xe9, 0xbb, 0x8e, 0x86, 0x35, 0x26, 0x9d, 0xc6, 0xa2, 0x3a, 0xb8, 0x2b,
+	0xfa, 0xe2, 0xd9, 0xf4, 0x68, 0xa7, 0x5c, 0xb9, 0xa7, 0x74, 0xe1, 0x1a, 0xbe, 0xfd, 0x3f, 0x00,
+	0x5d, 0xdd, 0xd2, 0xc3, 0x72, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,28 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pb
+
+// Capabilities is a set of optional protocol features.
+type Capabilities map[string]struct{}
+
+// NegotiateCapabilities returns the capabilities which are both listed in the
+// request header and supported by the server.
+func NegotiateCapabilities(header *RequestHeader, supported ...string) Capabilities {
+	negotiated := Capabilities{}
+	for _, capability := range header.GetCapabilities() {
+		for _, s := range supported {
+			if capability == s {
+				negotiated[capability] = struct{}{}
+				break
+			}
+		}
+	}
+	return negotiated
+}
+
+// Has returns whether the capability is in the set.
+func (capabilities Capabilities) Has(capability string) bool {
+	_, ok := capabilities[capability]
+	return ok
+}
@@ -0,0 +1,27 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+)
+
+func TestNegotiateCapabilities(t *testing.T) {
+	header := &pb.RequestHeader{Capabilities: []string{"a", "b", "c"}}
+
+	negotiated := pb.NegotiateCapabilities(header, "b", "c", "d")
+	require.False(t, negotiated.Has("a"))
+	require.True(t, negotiated.Has("b"))
+	require.True(t, negotiated.Has("c"))
+	require.False(t, negotiated.Has("d"))
+
+	// old clients don't send any capabilities.
+	negotiated = pb.NegotiateCapabilities(nil, "b")
+	require.False(t, negotiated.Has("b"))
+	require.Empty(t, negotiated)
+}
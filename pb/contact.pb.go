@@ -24,13 +24,16 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type CheckInRequest struct {
-	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Version              *NodeVersion  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Capacity             *NodeCapacity `protobuf:"bytes,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Operator             *NodeOperator `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Address  string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Version  *NodeVersion  `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Capacity *NodeCapacity `protobuf:"bytes,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Operator *NodeOperator `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	// capabilities lists the optional features supported by the node, so
+	// that the satellite can only select capable nodes for them.
+	Capabilities         []string `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CheckInRequest) Reset()         { *m = CheckInRequest{} }
@@ -85,6 +88,13 @@ func (m *CheckInRequest) GetOperator() *NodeOperator {
 	return nil
 }
 
+func (m *CheckInRequest) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type CheckInResponse struct {
 	PingNodeSuccess      bool     `protobuf:"varint,1,opt,name=ping_node_success,json=pingNodeSuccess,proto3" json:"ping_node_success,omitempty"`
 	PingErrorMessage     string   `protobuf:"bytes,2,opt,name=ping_error_message,json=pingErrorMessage,proto3" json:"ping_error_message,omitempty"`
@@ -357,36 +367,37 @@ func init() {
 func init() { proto.RegisterFile("contact.proto", fileDescriptor_a5036fff2565fb15) }
 
 var fileDescriptor_a5036fff2565fb15 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x50, 0xd5, 0xc9, 0x54, 0x4d, 0xda, 0x4d, 0x69, 0x2d, 0x83, 0x94, 0xca, 0xa7, 0x0a,
-	0x90, 0x23, 0xd2, 0x13, 0x52, 0x4f, 0x89, 0x2a, 0xc4, 0xa1, 0x10, 0x96, 0xc0, 0x01, 0x21, 0x45,
-	0xce, 0x66, 0x31, 0x06, 0xec, 0x71, 0x77, 0xd7, 0x48, 0xbc, 0x05, 0x8f, 0xc0, 0x5b, 0x70, 0xe2,
-	0xce, 0x53, 0xc0, 0xab, 0x20, 0xef, 0x8f, 0xdd, 0x24, 0x48, 0xdc, 0x76, 0xe7, 0xfb, 0xe6, 0x9b,
-	0x9f, 0x6f, 0x60, 0x9f, 0x61, 0xa1, 0x12, 0xa6, 0xe2, 0x52, 0xa0, 0x42, 0xe2, 0xdb, 0x6f, 0x08,
-	0x29, 0xa6, 0x68, 0x82, 0xe1, 0x30, 0x45, 0x4c, 0x3f, 0xf3, 0x91, 0xfe, 0x2d, 0xab, 0xf7, 0x23,
-	0x95, 0xe5, 0x5c, 0xaa, 0x24, 0x2f, 0x2d, 0x01, 0x0a, 0x5c, 0x71, 0xf3, 0x8e, 0x7e, 0x78, 0xd0,
-	0x9b, 0x7e, 0xe0, 0xec, 0xd3, 0xb3, 0x82, 0xf2, 0xeb, 0x8a, 0x4b, 0x45, 0x02, 0xf0, 0x93, 0xd5,
-	0x4a, 0x70, 0x29, 0x03, 0xef, 0xd4, 0x3b, 0xeb, 0x52, 0xf7, 0x25, 0x0f, 0xc1, 0xff, 0xc2, 0x85,
-	0xcc, 0xb0, 0x08, 0x6e, 0x9f, 0x7a, 0x67, 0x7b, 0xe3, 0xc3, 0x58, 0x4b, 0x3d, 0xc7, 0x15, 0x7f,
-	0x63, 0x00, 0xea, 0x18, 0x24, 0x86, 0x0e, 0x4b, 0xca, 0x84, 0x65, 0xea, 0x6b, 0x70, 0x47, 0xb3,
-	0x49, 0xcb, 0x9e, 0x5a, 0x84, 0x36, 0x9c, 0x9a, 0x8f, 0x25, 0x17, 0x89, 0x42, 0x11, 0xec, 0x6c,
-	0xf2, 0x5f, 0x58, 0x84, 0x36, 0x9c, 0xe8, 0xbb, 0x07, 0xfd, 0xa6, 0x73, 0x59, 0x62, 0x21, 0x39,
-	0x79, 0x00, 0x87, 0x65, 0x56, 0xa4, 0x8b, 0x3a, 0x6f, 0x21, 0x2b, 0xc6, 0xdc, 0x10, 0x1d, 0xda,
-	0xaf, 0x81, 0x5a, 0xea, 0x95, 0x09, 0x93, 0x47, 0x40, 0x34, 0x97, 0x0b, 0x81, 0x62, 0x91, 0x73,
-	0x29, 0x93, 0x94, 0xeb, 0xb9, 0xba, 0xf4, 0xa0, 0x46, 0x2e, 0x6b, 0xe0, 0xca, 0xc4, 0xc9, 0x39,
-	0x1c, 0x6f, 0x29, 0x2f, 0xae, 0xab, 0x8c, 0xe9, 0xd9, 0x3a, 0x74, 0xb0, 0x21, 0xff, 0xb2, 0xca,
-	0x58, 0x74, 0x00, 0xbd, 0xa7, 0x5c, 0xcd, 0xb3, 0x9c, 0xdb, 0xdd, 0x46, 0xaf, 0xa1, 0xdf, 0x44,
-	0x6c, 0xcf, 0x13, 0xe8, 0x36, 0x06, 0xe9, 0x5e, 0xf7, 0xc6, 0x61, 0x6c, 0x2c, 0x8c, 0x9d, 0x85,
-	0xf1, 0xdc, 0x31, 0x26, 0x9d, 0x5f, 0xbf, 0x87, 0xb7, 0xbe, 0xfd, 0x19, 0x7a, 0xb4, 0x4d, 0x8b,
-	0x8e, 0x80, 0x4c, 0xcd, 0x25, 0xcc, 0xb2, 0x22, 0x75, 0xc5, 0xee, 0xc2, 0x60, 0x2d, 0x6a, 0x0a,
-	0x46, 0xef, 0x60, 0xbf, 0xfe, 0x5f, 0xf1, 0xff, 0x1b, 0xfe, 0x18, 0xba, 0x4a, 0x24, 0x85, 0x2c,
-	0x51, 0x28, 0xbd, 0x9a, 0xde, 0x78, 0xd0, 0x9a, 0x32, 0x77, 0x10, 0x6d, 0x59, 0xf5, 0xcc, 0x4e,
-	0xdd, 0xd4, 0x1b, 0xcf, 0xc0, 0xb7, 0x6d, 0x90, 0x4b, 0xe8, 0xcc, 0xec, 0x9e, 0xc8, 0xbd, 0xd8,
-	0xdd, 0xf2, 0x76, 0xeb, 0xe1, 0xfd, 0x7f, 0x83, 0x56, 0xf1, 0xa7, 0x07, 0x3b, 0x5a, 0xe3, 0x02,
-	0x7c, 0x7b, 0x02, 0xe4, 0xa4, 0xcd, 0x58, 0x3b, 0xe7, 0x30, 0xd8, 0x06, 0xec, 0xe6, 0x9f, 0xc0,
-	0xae, 0x69, 0x95, 0x1c, 0x37, 0x9c, 0xb5, 0xcd, 0x84, 0x27, 0x5b, 0x71, 0x9b, 0x7a, 0x01, 0xbe,
-	0xf5, 0xf1, 0x46, 0xe1, 0x75, 0xaf, 0x6f, 0x14, 0xde, 0xb0, 0x7c, 0x72, 0xf4, 0x96, 0x48, 0x85,
-	0xe2, 0x63, 0x9c, 0xe1, 0x88, 0x61, 0x9e, 0x63, 0x31, 0x2a, 0x97, 0xcb, 0x5d, 0xed, 0xf6, 0xf9,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xef, 0xff, 0xf4, 0xe4, 0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xfe, 0xe5, 0xb7, 0xb1, 0x34, 0x67, 0xac, 0xdd, 0xdc, 0xb1, 0x45, 0x01, 0xa9, 0x55, 0xae,
+	0x2a, 0x40, 0xa9, 0xe8, 0xae, 0x90, 0x76, 0xd5, 0x6a, 0x42, 0x5c, 0x0c, 0x8a, 0x29, 0x5c, 0x20,
+	0xa4, 0x2a, 0x75, 0x4d, 0x30, 0x2c, 0x71, 0x66, 0x3b, 0x48, 0xbc, 0x05, 0x8f, 0xc0, 0x8b, 0x70,
+	0xcf, 0x3b, 0x20, 0xc1, 0xab, 0xa0, 0xd8, 0x4e, 0x42, 0x16, 0x24, 0xee, 0xec, 0xf3, 0x7d, 0xe7,
+	0x3b, 0x7f, 0xbe, 0x03, 0x07, 0x84, 0x67, 0x2a, 0x26, 0x2a, 0xca, 0x05, 0x57, 0x1c, 0xb9, 0xf6,
+	0x1b, 0x40, 0xc2, 0x13, 0x6e, 0x82, 0xc1, 0x28, 0xe1, 0x3c, 0xb9, 0xa2, 0x53, 0xfd, 0xdb, 0x14,
+	0xef, 0xa6, 0x8a, 0xa5, 0x54, 0xaa, 0x38, 0xcd, 0x2d, 0x01, 0x32, 0xbe, 0xa5, 0xe6, 0x1d, 0xfe,
+	0x70, 0xa0, 0xbf, 0x78, 0x4f, 0xc9, 0xc7, 0xa7, 0x19, 0xa6, 0xd7, 0x05, 0x95, 0x0a, 0xf9, 0xe0,
+	0xc6, 0xdb, 0xad, 0xa0, 0x52, 0xfa, 0xce, 0xd8, 0x99, 0x78, 0xb8, 0xfa, 0xa2, 0x07, 0xe0, 0x7e,
+	0xa2, 0x42, 0x32, 0x9e, 0xf9, 0xff, 0x8f, 0x9d, 0xc9, 0xfe, 0xec, 0x28, 0xd2, 0x52, 0xcf, 0xf8,
+	0x96, 0xbe, 0x36, 0x00, 0xae, 0x18, 0x28, 0x82, 0x1e, 0x89, 0xf3, 0x98, 0x30, 0xf5, 0xd9, 0xdf,
+	0xd1, 0x6c, 0xd4, 0xb0, 0x17, 0x16, 0xc1, 0x35, 0xa7, 0xe4, 0xf3, 0x9c, 0x8a, 0x58, 0x71, 0xe1,
+	0xef, 0xde, 0xe4, 0x3f, 0xb7, 0x08, 0xae, 0x39, 0x28, 0x84, 0xdb, 0x65, 0xee, 0x86, 0x5d, 0x31,
+	0xc5, 0xa8, 0xf4, 0x6f, 0x8d, 0x77, 0x26, 0x1e, 0x6e, 0xc5, 0xc2, 0xaf, 0x0e, 0x0c, 0xea, 0xe9,
+	0x64, 0xce, 0x33, 0x49, 0xd1, 0x7d, 0x38, 0xca, 0x59, 0x96, 0xac, 0x4b, 0xed, 0xb5, 0x2c, 0x08,
+	0xa9, 0x06, 0xed, 0xe1, 0x41, 0x09, 0x94, 0xe5, 0x5e, 0x9a, 0x30, 0x7a, 0x08, 0x48, 0x73, 0xa9,
+	0x10, 0x5c, 0xac, 0x53, 0x2a, 0x65, 0x9c, 0x50, 0x3d, 0xbb, 0x87, 0x0f, 0x4b, 0xe4, 0xa2, 0x04,
+	0x2e, 0x4d, 0x1c, 0x9d, 0xc1, 0x49, 0x47, 0x79, 0x7d, 0x5d, 0x30, 0xa2, 0xe7, 0xef, 0xe1, 0xe1,
+	0x0d, 0xf9, 0x17, 0x05, 0x23, 0xe1, 0x21, 0xf4, 0x9f, 0x50, 0xb5, 0x62, 0x29, 0xb5, 0xfb, 0x0f,
+	0x5f, 0xc1, 0xa0, 0x8e, 0xd8, 0x9e, 0xe7, 0xe0, 0xd5, 0x26, 0xea, 0x5e, 0xf7, 0x67, 0x41, 0x64,
+	0x6c, 0x8e, 0x2a, 0x9b, 0xa3, 0x55, 0xc5, 0x98, 0xf7, 0xbe, 0xff, 0x1c, 0xfd, 0xf7, 0xe5, 0xd7,
+	0xc8, 0xc1, 0x4d, 0x5a, 0x78, 0x0c, 0x68, 0x61, 0xae, 0x65, 0xc9, 0xb2, 0xa4, 0x2a, 0x76, 0x07,
+	0x86, 0xad, 0xa8, 0x29, 0x18, 0xbe, 0x85, 0x83, 0xf2, 0x7f, 0x49, 0xff, 0x7d, 0x14, 0x8f, 0xc0,
+	0x53, 0x22, 0xce, 0x64, 0xce, 0x85, 0xd2, 0xab, 0xe9, 0xcf, 0x86, 0x8d, 0x71, 0xab, 0x0a, 0xc2,
+	0x0d, 0xab, 0x9c, 0xb9, 0x52, 0x37, 0xf5, 0x66, 0x4b, 0x70, 0x6d, 0x1b, 0xe8, 0x02, 0x7a, 0x4b,
+	0xbb, 0x27, 0x74, 0x37, 0xaa, 0xee, 0xbd, 0xdb, 0x7a, 0x70, 0xef, 0xef, 0xa0, 0x55, 0xfc, 0xe6,
+	0xc0, 0xae, 0xd6, 0x38, 0x07, 0xd7, 0x9e, 0x00, 0x3a, 0x6d, 0x32, 0x5a, 0x27, 0x1f, 0xf8, 0x5d,
+	0xc0, 0x6e, 0xfe, 0x31, 0xec, 0x99, 0x56, 0xd1, 0x49, 0xcd, 0x69, 0x6d, 0x26, 0x38, 0xed, 0xc4,
+	0x6d, 0xea, 0x39, 0xb8, 0xd6, 0xc7, 0x3f, 0x0a, 0xb7, 0xbd, 0x0e, 0xfc, 0x2e, 0x60, 0xb2, 0xe7,
+	0xc7, 0x6f, 0x90, 0x54, 0x5c, 0x7c, 0x88, 0x18, 0x9f, 0x12, 0x9e, 0xa6, 0x3c, 0x9b, 0xe6, 0x9b,
+	0xcd, 0x9e, 0x76, 0xfb, 0xec, 0xf7, 0x00, 0x39, 0x99, 0xb1, 0xb1, 0x08, 0x04, 0x00, 0x00,
 }
@@ -23,6 +23,39 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Versioning is the object versioning state of a bucket.
+type Versioning int32
+
+const (
+	// UNVERSIONED buckets overwrite objects on re-upload.
+	Versioning_UNVERSIONED Versioning = 0
+	// ENABLED buckets keep prior versions when an object is overwritten.
+	Versioning_ENABLED Versioning = 1
+	// SUSPENDED buckets keep already existing versions,
+	// but new uploads overwrite the latest version.
+	Versioning_SUSPENDED Versioning = 2
+)
+
+var Versioning_name = map[int32]string{
+	0: "UNVERSIONED",
+	1: "ENABLED",
+	2: "SUSPENDED",
+}
+
+var Versioning_value = map[string]int32{
+	"UNVERSIONED": 0,
+	"ENABLED":     1,
+	"SUSPENDED":   2,
+}
+
+func (x Versioning) String() string {
+	return proto.EnumName(Versioning_name, int32(x))
+}
+
+func (Versioning) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{0}
+}
+
 type Object_Status int32
 
 const (
@@ -54,12 +87,50 @@ func (x Object_Status) String() string {
 }
 
 func (Object_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{16, 0}
+	return fileDescriptor_631e2f30a93cd64e, []int{19, 0}
+}
+
+type ObjectsDeleteResponseItem_Status int32
+
+const (
+	ObjectsDeleteResponseItem_INVALID   ObjectsDeleteResponseItem_Status = 0
+	ObjectsDeleteResponseItem_DELETED   ObjectsDeleteResponseItem_Status = 1
+	ObjectsDeleteResponseItem_NOT_FOUND ObjectsDeleteResponseItem_Status = 2
+	ObjectsDeleteResponseItem_FAILED    ObjectsDeleteResponseItem_Status = 3
+	// LOCKED is used when the object is protected by object lock.
+	ObjectsDeleteResponseItem_LOCKED ObjectsDeleteResponseItem_Status = 4
+)
+
+var ObjectsDeleteResponseItem_Status_name = map[int32]string{
+	0: "INVALID",
+	1: "DELETED",
+	2: "NOT_FOUND",
+	3: "FAILED",
+	4: "LOCKED",
+}
+
+var ObjectsDeleteResponseItem_Status_value = map[string]int32{
+	"INVALID":   0,
+	"DELETED":   1,
+	"NOT_FOUND": 2,
+	"FAILED":    3,
+	"LOCKED":    4,
+}
+
+func (x ObjectsDeleteResponseItem_Status) String() string {
+	return proto.EnumName(ObjectsDeleteResponseItem_Status_name, int32(x))
+}
+
+func (ObjectsDeleteResponseItem_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{44, 0}
 }
 
 type RequestHeader struct {
-	ApiKey               []byte   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	UserAgent            []byte   `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	ApiKey    []byte `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	UserAgent []byte `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// capabilities lists the optional protocol features supported by the
+	// client. The satellite only uses new behavior the client has listed.
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -103,6 +174,13 @@ func (m *RequestHeader) GetUserAgent() []byte {
 	return nil
 }
 
+func (m *RequestHeader) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type Bucket struct {
 	Name                        []byte                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	PathCipher                  CipherSuite           `protobuf:"varint,2,opt,name=path_cipher,json=pathCipher,proto3,enum=encryption.CipherSuite" json:"path_cipher,omitempty"`
@@ -111,6 +189,8 @@ type Bucket struct {
 	DefaultRedundancyScheme     *RedundancyScheme     `protobuf:"bytes,5,opt,name=default_redundancy_scheme,json=defaultRedundancyScheme,proto3" json:"default_redundancy_scheme,omitempty"`
 	DefaultEncryptionParameters *EncryptionParameters `protobuf:"bytes,6,opt,name=default_encryption_parameters,json=defaultEncryptionParameters,proto3" json:"default_encryption_parameters,omitempty"`
 	PartnerId                   []byte                `protobuf:"bytes,7,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Versioning                  Versioning            `protobuf:"varint,8,opt,name=versioning,proto3,enum=metainfo.Versioning" json:"versioning,omitempty"`
+	ObjectLock                  *ObjectLock           `protobuf:"bytes,9,opt,name=object_lock,json=objectLock,proto3" json:"object_lock,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{}              `json:"-"`
 	XXX_unrecognized            []byte                `json:"-"`
 	XXX_sizecache               int32                 `json:"-"`
@@ -189,6 +269,69 @@ func (m *Bucket) GetPartnerId() []byte {
 	return nil
 }
 
+func (m *Bucket) GetVersioning() Versioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return Versioning_UNVERSIONED
+}
+
+func (m *Bucket) GetObjectLock() *ObjectLock {
+	if m != nil {
+		return m.ObjectLock
+	}
+	return nil
+}
+
+// ObjectLock is the default object lock configuration of a bucket.
+// Objects in a locked bucket cannot be deleted or overwritten
+// until their retention period expires.
+type ObjectLock struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DefaultRetentionDays int32    `protobuf:"varint,2,opt,name=default_retention_days,json=defaultRetentionDays,proto3" json:"default_retention_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectLock) Reset()         { *m = ObjectLock{} }
+func (m *ObjectLock) String() string { return proto.CompactTextString(m) }
+func (*ObjectLock) ProtoMessage()    {}
+func (*ObjectLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{2}
+}
+func (m *ObjectLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectLock.Unmarshal(m, b)
+}
+func (m *ObjectLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectLock.Marshal(b, m, deterministic)
+}
+func (m *ObjectLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectLock.Merge(m, src)
+}
+func (m *ObjectLock) XXX_Size() int {
+	return xxx_messageInfo_ObjectLock.Size(m)
+}
+func (m *ObjectLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectLock proto.InternalMessageInfo
+
+func (m *ObjectLock) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ObjectLock) GetDefaultRetentionDays() int32 {
+	if m != nil {
+		return m.DefaultRetentionDays
+	}
+	return 0
+}
+
 type BucketListItem struct {
 	Name                 []byte    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	UserAgent            []byte    `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
@@ -202,7 +345,7 @@ func (m *BucketListItem) Reset()         { *m = BucketListItem{} }
 func (m *BucketListItem) String() string { return proto.CompactTextString(m) }
 func (*BucketListItem) ProtoMessage()    {}
 func (*BucketListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{2}
+	return fileDescriptor_631e2f30a93cd64e, []int{3}
 }
 func (m *BucketListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListItem.Unmarshal(m, b)
@@ -251,6 +394,8 @@ type BucketCreateRequest struct {
 	DefaultRedundancyScheme     *RedundancyScheme     `protobuf:"bytes,4,opt,name=default_redundancy_scheme,json=defaultRedundancyScheme,proto3" json:"default_redundancy_scheme,omitempty"`
 	DefaultEncryptionParameters *EncryptionParameters `protobuf:"bytes,5,opt,name=default_encryption_parameters,json=defaultEncryptionParameters,proto3" json:"default_encryption_parameters,omitempty"`
 	PartnerId                   []byte                `protobuf:"bytes,6,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Versioning                  Versioning            `protobuf:"varint,7,opt,name=versioning,proto3,enum=metainfo.Versioning" json:"versioning,omitempty"`
+	ObjectLock                  *ObjectLock           `protobuf:"bytes,8,opt,name=object_lock,json=objectLock,proto3" json:"object_lock,omitempty"`
 	XXX_NoUnkeyedLiteral        struct{}              `json:"-"`
 	XXX_unrecognized            []byte                `json:"-"`
 	XXX_sizecache               int32                 `json:"-"`
@@ -260,7 +405,7 @@ func (m *BucketCreateRequest) Reset()         { *m = BucketCreateRequest{} }
 func (m *BucketCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketCreateRequest) ProtoMessage()    {}
 func (*BucketCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{3}
+	return fileDescriptor_631e2f30a93cd64e, []int{4}
 }
 func (m *BucketCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketCreateRequest.Unmarshal(m, b)
@@ -329,6 +474,20 @@ func (m *BucketCreateRequest) GetPartnerId() []byte {
 	return nil
 }
 
+func (m *BucketCreateRequest) GetVersioning() Versioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return Versioning_UNVERSIONED
+}
+
+func (m *BucketCreateRequest) GetObjectLock() *ObjectLock {
+	if m != nil {
+		return m.ObjectLock
+	}
+	return nil
+}
+
 type BucketCreateResponse struct {
 	Bucket               *Bucket  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -340,7 +499,7 @@ func (m *BucketCreateResponse) Reset()         { *m = BucketCreateResponse{} }
 func (m *BucketCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketCreateResponse) ProtoMessage()    {}
 func (*BucketCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{4}
+	return fileDescriptor_631e2f30a93cd64e, []int{5}
 }
 func (m *BucketCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketCreateResponse.Unmarshal(m, b)
@@ -379,7 +538,7 @@ func (m *BucketGetRequest) Reset()         { *m = BucketGetRequest{} }
 func (m *BucketGetRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketGetRequest) ProtoMessage()    {}
 func (*BucketGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{5}
+	return fileDescriptor_631e2f30a93cd64e, []int{6}
 }
 func (m *BucketGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketGetRequest.Unmarshal(m, b)
@@ -424,7 +583,7 @@ func (m *BucketGetResponse) Reset()         { *m = BucketGetResponse{} }
 func (m *BucketGetResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketGetResponse) ProtoMessage()    {}
 func (*BucketGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{6}
+	return fileDescriptor_631e2f30a93cd64e, []int{7}
 }
 func (m *BucketGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketGetResponse.Unmarshal(m, b)
@@ -464,7 +623,7 @@ func (m *BucketDeleteRequest) Reset()         { *m = BucketDeleteRequest{} }
 func (m *BucketDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketDeleteRequest) ProtoMessage()    {}
 func (*BucketDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{7}
+	return fileDescriptor_631e2f30a93cd64e, []int{8}
 }
 func (m *BucketDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketDeleteRequest.Unmarshal(m, b)
@@ -517,7 +676,7 @@ func (m *BucketDeleteResponse) Reset()         { *m = BucketDeleteResponse{} }
 func (m *BucketDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketDeleteResponse) ProtoMessage()    {}
 func (*BucketDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{8}
+	return fileDescriptor_631e2f30a93cd64e, []int{9}
 }
 func (m *BucketDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketDeleteResponse.Unmarshal(m, b)
@@ -565,7 +724,7 @@ func (m *BucketListRequest) Reset()         { *m = BucketListRequest{} }
 func (m *BucketListRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketListRequest) ProtoMessage()    {}
 func (*BucketListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{9}
+	return fileDescriptor_631e2f30a93cd64e, []int{10}
 }
 func (m *BucketListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListRequest.Unmarshal(m, b)
@@ -625,7 +784,7 @@ func (m *BucketListResponse) Reset()         { *m = BucketListResponse{} }
 func (m *BucketListResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketListResponse) ProtoMessage()    {}
 func (*BucketListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{10}
+	return fileDescriptor_631e2f30a93cd64e, []int{11}
 }
 func (m *BucketListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketListResponse.Unmarshal(m, b)
@@ -672,7 +831,7 @@ func (m *BucketSetAttributionRequest) Reset()         { *m = BucketSetAttributio
 func (m *BucketSetAttributionRequest) String() string { return proto.CompactTextString(m) }
 func (*BucketSetAttributionRequest) ProtoMessage()    {}
 func (*BucketSetAttributionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{11}
+	return fileDescriptor_631e2f30a93cd64e, []int{12}
 }
 func (m *BucketSetAttributionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketSetAttributionRequest.Unmarshal(m, b)
@@ -723,7 +882,7 @@ func (m *BucketSetAttributionResponse) Reset()         { *m = BucketSetAttributi
 func (m *BucketSetAttributionResponse) String() string { return proto.CompactTextString(m) }
 func (*BucketSetAttributionResponse) ProtoMessage()    {}
 func (*BucketSetAttributionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{12}
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
 }
 func (m *BucketSetAttributionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketSetAttributionResponse.Unmarshal(m, b)
@@ -743,6 +902,98 @@ func (m *BucketSetAttributionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BucketSetAttributionResponse proto.InternalMessageInfo
 
+type BucketSetVersioningRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Versioning           Versioning     `protobuf:"varint,2,opt,name=versioning,proto3,enum=metainfo.Versioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BucketSetVersioningRequest) Reset()         { *m = BucketSetVersioningRequest{} }
+func (m *BucketSetVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningRequest) ProtoMessage()    {}
+func (*BucketSetVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
+}
+func (m *BucketSetVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningRequest.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningRequest.Merge(m, src)
+}
+func (m *BucketSetVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningRequest.Size(m)
+}
+func (m *BucketSetVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningRequest proto.InternalMessageInfo
+
+func (m *BucketSetVersioningRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *BucketSetVersioningRequest) GetVersioning() Versioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return Versioning_UNVERSIONED
+}
+
+type BucketSetVersioningResponse struct {
+	Bucket               *Bucket  `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketSetVersioningResponse) Reset()         { *m = BucketSetVersioningResponse{} }
+func (m *BucketSetVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*BucketSetVersioningResponse) ProtoMessage()    {}
+func (*BucketSetVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{15}
+}
+func (m *BucketSetVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketSetVersioningResponse.Unmarshal(m, b)
+}
+func (m *BucketSetVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketSetVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *BucketSetVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketSetVersioningResponse.Merge(m, src)
+}
+func (m *BucketSetVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_BucketSetVersioningResponse.Size(m)
+}
+func (m *BucketSetVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketSetVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketSetVersioningResponse proto.InternalMessageInfo
+
+func (m *BucketSetVersioningResponse) GetBucket() *Bucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
 type AddressedOrderLimit struct {
 	Limit                *OrderLimit  `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	StorageNodeAddress   *NodeAddress `protobuf:"bytes,2,opt,name=storage_node_address,json=storageNodeAddress,proto3" json:"storage_node_address,omitempty"`
@@ -755,7 +1006,7 @@ func (m *AddressedOrderLimit) Reset()         { *m = AddressedOrderLimit{} }
 func (m *AddressedOrderLimit) String() string { return proto.CompactTextString(m) }
 func (*AddressedOrderLimit) ProtoMessage()    {}
 func (*AddressedOrderLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{13}
+	return fileDescriptor_631e2f30a93cd64e, []int{16}
 }
 func (m *AddressedOrderLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddressedOrderLimit.Unmarshal(m, b)
@@ -800,7 +1051,7 @@ func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
 func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoRequest) ProtoMessage()    {}
 func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{14}
+	return fileDescriptor_631e2f30a93cd64e, []int{17}
 }
 func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
@@ -838,7 +1089,7 @@ func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
 func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectInfoResponse) ProtoMessage()    {}
 func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{15}
+	return fileDescriptor_631e2f30a93cd64e, []int{18}
 }
 func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
@@ -888,17 +1139,19 @@ type Object struct {
 	// size of remote part of object.
 	RemoteSize int64 `protobuf:"varint,16,opt,name=remote_size,json=remoteSize,proto3" json:"remote_size,omitempty"`
 	// plain_size is 0 for migrated objects.
-	PlainSize            int64    `protobuf:"varint,18,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PlainSize int64 `protobuf:"varint,18,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
+	// retain_until is set when the object is protected by object lock.
+	RetainUntil          time.Time `protobuf:"bytes,19,opt,name=retain_until,json=retainUntil,proto3,stdtime" json:"retain_until"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{16}
+	return fileDescriptor_631e2f30a93cd64e, []int{19}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Object.Unmarshal(m, b)
@@ -1030,6 +1283,13 @@ func (m *Object) GetPlainSize() int64 {
 	return 0
 }
 
+func (m *Object) GetRetainUntil() time.Time {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return time.Time{}
+}
+
 type ObjectBeginRequest struct {
 	Header                        *RequestHeader        `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
 	Bucket                        []byte                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
@@ -1050,7 +1310,7 @@ func (m *ObjectBeginRequest) Reset()         { *m = ObjectBeginRequest{} }
 func (m *ObjectBeginRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginRequest) ProtoMessage()    {}
 func (*ObjectBeginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{17}
+	return fileDescriptor_631e2f30a93cd64e, []int{20}
 }
 func (m *ObjectBeginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginRequest.Unmarshal(m, b)
@@ -1149,7 +1409,7 @@ func (m *ObjectBeginResponse) Reset()         { *m = ObjectBeginResponse{} }
 func (m *ObjectBeginResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginResponse) ProtoMessage()    {}
 func (*ObjectBeginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{18}
+	return fileDescriptor_631e2f30a93cd64e, []int{21}
 }
 func (m *ObjectBeginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginResponse.Unmarshal(m, b)
@@ -1224,7 +1484,7 @@ func (m *ObjectCommitRequest) Reset()         { *m = ObjectCommitRequest{} }
 func (m *ObjectCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectCommitRequest) ProtoMessage()    {}
 func (*ObjectCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{19}
+	return fileDescriptor_631e2f30a93cd64e, []int{22}
 }
 func (m *ObjectCommitRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectCommitRequest.Unmarshal(m, b)
@@ -1282,7 +1542,7 @@ func (m *ObjectCommitResponse) Reset()         { *m = ObjectCommitResponse{} }
 func (m *ObjectCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectCommitResponse) ProtoMessage()    {}
 func (*ObjectCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{20}
+	return fileDescriptor_631e2f30a93cd64e, []int{23}
 }
 func (m *ObjectCommitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectCommitResponse.Unmarshal(m, b)
@@ -1317,7 +1577,7 @@ func (m *ObjectListPendingStreamsRequest) Reset()         { *m = ObjectListPendi
 func (m *ObjectListPendingStreamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectListPendingStreamsRequest) ProtoMessage()    {}
 func (*ObjectListPendingStreamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{21}
+	return fileDescriptor_631e2f30a93cd64e, []int{24}
 }
 func (m *ObjectListPendingStreamsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListPendingStreamsRequest.Unmarshal(m, b)
@@ -1384,7 +1644,7 @@ func (m *ObjectListPendingStreamsResponse) Reset()         { *m = ObjectListPend
 func (m *ObjectListPendingStreamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectListPendingStreamsResponse) ProtoMessage()    {}
 func (*ObjectListPendingStreamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{22}
+	return fileDescriptor_631e2f30a93cd64e, []int{25}
 }
 func (m *ObjectListPendingStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListPendingStreamsResponse.Unmarshal(m, b)
@@ -1435,7 +1695,7 @@ func (m *ObjectDownloadRequest) Reset()         { *m = ObjectDownloadRequest{} }
 func (m *ObjectDownloadRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectDownloadRequest) ProtoMessage()    {}
 func (*ObjectDownloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{23}
+	return fileDescriptor_631e2f30a93cd64e, []int{26}
 }
 func (m *ObjectDownloadRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectDownloadRequest.Unmarshal(m, b)
@@ -1505,7 +1765,7 @@ func (m *Range) Reset()         { *m = Range{} }
 func (m *Range) String() string { return proto.CompactTextString(m) }
 func (*Range) ProtoMessage()    {}
 func (*Range) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{24}
+	return fileDescriptor_631e2f30a93cd64e, []int{27}
 }
 func (m *Range) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Range.Unmarshal(m, b)
@@ -1597,7 +1857,7 @@ func (m *RangeStartLimit) Reset()         { *m = RangeStartLimit{} }
 func (m *RangeStartLimit) String() string { return proto.CompactTextString(m) }
 func (*RangeStartLimit) ProtoMessage()    {}
 func (*RangeStartLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{25}
+	return fileDescriptor_631e2f30a93cd64e, []int{28}
 }
 func (m *RangeStartLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RangeStartLimit.Unmarshal(m, b)
@@ -1644,7 +1904,7 @@ func (m *RangeStart) Reset()         { *m = RangeStart{} }
 func (m *RangeStart) String() string { return proto.CompactTextString(m) }
 func (*RangeStart) ProtoMessage()    {}
 func (*RangeStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{26}
+	return fileDescriptor_631e2f30a93cd64e, []int{29}
 }
 func (m *RangeStart) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RangeStart.Unmarshal(m, b)
@@ -1685,7 +1945,7 @@ func (m *RangeSuffix) Reset()         { *m = RangeSuffix{} }
 func (m *RangeSuffix) String() string { return proto.CompactTextString(m) }
 func (*RangeSuffix) ProtoMessage()    {}
 func (*RangeSuffix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{27}
+	return fileDescriptor_631e2f30a93cd64e, []int{30}
 }
 func (m *RangeSuffix) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RangeSuffix.Unmarshal(m, b)
@@ -1736,7 +1996,7 @@ func (m *ObjectDownloadResponse) Reset()         { *m = ObjectDownloadResponse{}
 func (m *ObjectDownloadResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectDownloadResponse) ProtoMessage()    {}
 func (*ObjectDownloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{28}
+	return fileDescriptor_631e2f30a93cd64e, []int{31}
 }
 func (m *ObjectDownloadResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectDownloadResponse.Unmarshal(m, b)
@@ -1782,11 +2042,15 @@ type ObjectGetRequest struct {
 	Bucket        []byte         `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	EncryptedPath []byte         `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
 	Version       int32          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// feature flag used by satellite to determine if uplink is
+	// Feature flag used by satellite to determine if uplink is
 	// using RS per object or RS per segment. If flag is set to false
 	// satellite will try to get RS from one of existing segments
 	// (e.g. first). If flag is set to true satellite won't return RS
 	// value in response for this request.
+	//
+	// Redundancy scheme on object level is a legacy feature:
+	// it is always determined per segment.
+	// Therefor, this flag should always be set to true.
 	RedundancySchemePerSegment bool     `protobuf:"varint,4,opt,name=redundancy_scheme_per_segment,json=redundancySchemePerSegment,proto3" json:"redundancy_scheme_per_segment,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
 	XXX_unrecognized           []byte   `json:"-"`
@@ -1797,7 +2061,7 @@ func (m *ObjectGetRequest) Reset()         { *m = ObjectGetRequest{} }
 func (m *ObjectGetRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectGetRequest) ProtoMessage()    {}
 func (*ObjectGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{29}
+	return fileDescriptor_631e2f30a93cd64e, []int{32}
 }
 func (m *ObjectGetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectGetRequest.Unmarshal(m, b)
@@ -1863,7 +2127,7 @@ func (m *ObjectGetResponse) Reset()         { *m = ObjectGetResponse{} }
 func (m *ObjectGetResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectGetResponse) ProtoMessage()    {}
 func (*ObjectGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{30}
+	return fileDescriptor_631e2f30a93cd64e, []int{33}
 }
 func (m *ObjectGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectGetResponse.Unmarshal(m, b)
@@ -1904,17 +2168,25 @@ type ObjectListRequest struct {
 	// to give satellite know that should be using object_includes,
 	// otherwise old uplinks can break. Newer uplinks should
 	// set this value always to true.
-	UseObjectIncludes    bool     `protobuf:"varint,8,opt,name=use_object_includes,json=useObjectIncludes,proto3" json:"use_object_includes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UseObjectIncludes bool `protobuf:"varint,8,opt,name=use_object_includes,json=useObjectIncludes,proto3" json:"use_object_includes,omitempty"`
+	// include_all_versions lists every version of an object,
+	// instead of only the latest one.
+	IncludeAllVersions bool `protobuf:"varint,9,opt,name=include_all_versions,json=includeAllVersions,proto3" json:"include_all_versions,omitempty"`
+	// created_after and created_before filter the listed objects
+	// by their creation time, when they are set. Both bounds are exclusive,
+	// objects created exactly at either timestamp are not listed.
+	CreatedAfter         time.Time `protobuf:"bytes,10,opt,name=created_after,json=createdAfter,proto3,stdtime" json:"created_after"`
+	CreatedBefore        time.Time `protobuf:"bytes,11,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *ObjectListRequest) Reset()         { *m = ObjectListRequest{} }
 func (m *ObjectListRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectListRequest) ProtoMessage()    {}
 func (*ObjectListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{31}
+	return fileDescriptor_631e2f30a93cd64e, []int{34}
 }
 func (m *ObjectListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListRequest.Unmarshal(m, b)
@@ -1997,6 +2269,27 @@ func (m *ObjectListRequest) GetUseObjectIncludes() bool {
 	return false
 }
 
+func (m *ObjectListRequest) GetIncludeAllVersions() bool {
+	if m != nil {
+		return m.IncludeAllVersions
+	}
+	return false
+}
+
+func (m *ObjectListRequest) GetCreatedAfter() time.Time {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return time.Time{}
+}
+
+func (m *ObjectListRequest) GetCreatedBefore() time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return time.Time{}
+}
+
 type ObjectListResponse struct {
 	Items                []*ObjectListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	More                 bool              `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
@@ -2009,7 +2302,7 @@ func (m *ObjectListResponse) Reset()         { *m = ObjectListResponse{} }
 func (m *ObjectListResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectListResponse) ProtoMessage()    {}
 func (*ObjectListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{32}
+	return fileDescriptor_631e2f30a93cd64e, []int{35}
 }
 func (m *ObjectListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListResponse.Unmarshal(m, b)
@@ -2054,18 +2347,20 @@ type ObjectListItem struct {
 	EncryptedMetadataEncryptedKey []byte        `protobuf:"bytes,11,opt,name=encrypted_metadata_encrypted_key,json=encryptedMetadataEncryptedKey,proto3" json:"encrypted_metadata_encrypted_key,omitempty"`
 	EncryptedMetadata             []byte        `protobuf:"bytes,8,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
 	// plain_size is 0 for migrated objects.
-	PlainSize            int64     `protobuf:"varint,10,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
-	StreamId             *StreamID `protobuf:"bytes,9,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	PlainSize int64     `protobuf:"varint,10,opt,name=plain_size,json=plainSize,proto3" json:"plain_size,omitempty"`
+	StreamId  *StreamID `protobuf:"bytes,9,opt,name=stream_id,json=streamId,proto3,customtype=StreamID" json:"stream_id,omitempty"`
+	// is_latest is set when the item is the latest version of the object.
+	IsLatest             bool     `protobuf:"varint,12,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ObjectListItem) Reset()         { *m = ObjectListItem{} }
 func (m *ObjectListItem) String() string { return proto.CompactTextString(m) }
 func (*ObjectListItem) ProtoMessage()    {}
 func (*ObjectListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{33}
+	return fileDescriptor_631e2f30a93cd64e, []int{36}
 }
 func (m *ObjectListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListItem.Unmarshal(m, b)
@@ -2148,6 +2443,13 @@ func (m *ObjectListItem) GetPlainSize() int64 {
 	return 0
 }
 
+func (m *ObjectListItem) GetIsLatest() bool {
+	if m != nil {
+		return m.IsLatest
+	}
+	return false
+}
+
 type ObjectListItemIncludes struct {
 	// rename to include_custom_metadata
 	Metadata bool `protobuf:"varint,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -2163,7 +2465,7 @@ func (m *ObjectListItemIncludes) Reset()         { *m = ObjectListItemIncludes{}
 func (m *ObjectListItemIncludes) String() string { return proto.CompactTextString(m) }
 func (*ObjectListItemIncludes) ProtoMessage()    {}
 func (*ObjectListItemIncludes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{34}
+	return fileDescriptor_631e2f30a93cd64e, []int{37}
 }
 func (m *ObjectListItemIncludes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectListItemIncludes.Unmarshal(m, b)
@@ -2213,7 +2515,7 @@ func (m *ObjectBeginDeleteRequest) Reset()         { *m = ObjectBeginDeleteReque
 func (m *ObjectBeginDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginDeleteRequest) ProtoMessage()    {}
 func (*ObjectBeginDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{35}
+	return fileDescriptor_631e2f30a93cd64e, []int{38}
 }
 func (m *ObjectBeginDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginDeleteRequest.Unmarshal(m, b)
@@ -2280,7 +2582,7 @@ func (m *ObjectBeginDeleteResponse) Reset()         { *m = ObjectBeginDeleteResp
 func (m *ObjectBeginDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginDeleteResponse) ProtoMessage()    {}
 func (*ObjectBeginDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{36}
+	return fileDescriptor_631e2f30a93cd64e, []int{39}
 }
 func (m *ObjectBeginDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginDeleteResponse.Unmarshal(m, b)
@@ -2319,7 +2621,7 @@ func (m *ObjectFinishDeleteRequest) Reset()         { *m = ObjectFinishDeleteReq
 func (m *ObjectFinishDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishDeleteRequest) ProtoMessage()    {}
 func (*ObjectFinishDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{37}
+	return fileDescriptor_631e2f30a93cd64e, []int{40}
 }
 func (m *ObjectFinishDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishDeleteRequest.Unmarshal(m, b)
@@ -2356,7 +2658,7 @@ func (m *ObjectFinishDeleteResponse) Reset()         { *m = ObjectFinishDeleteRe
 func (m *ObjectFinishDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishDeleteResponse) ProtoMessage()    {}
 func (*ObjectFinishDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{38}
+	return fileDescriptor_631e2f30a93cd64e, []int{41}
 }
 func (m *ObjectFinishDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishDeleteResponse.Unmarshal(m, b)
@@ -2376,6 +2678,270 @@ func (m *ObjectFinishDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObjectFinishDeleteResponse proto.InternalMessageInfo
 
+// ObjectsDeleteRequest deletes multiple committed objects from a single bucket.
+// The satellite limits the number of encrypted paths per request.
+type ObjectsDeleteRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte         `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPaths       [][]byte       `protobuf:"bytes,2,rep,name=encrypted_paths,json=encryptedPaths,proto3" json:"encrypted_paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ObjectsDeleteRequest) Reset()         { *m = ObjectsDeleteRequest{} }
+func (m *ObjectsDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectsDeleteRequest) ProtoMessage()    {}
+func (*ObjectsDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{42}
+}
+func (m *ObjectsDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectsDeleteRequest.Unmarshal(m, b)
+}
+func (m *ObjectsDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectsDeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectsDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectsDeleteRequest.Merge(m, src)
+}
+func (m *ObjectsDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectsDeleteRequest.Size(m)
+}
+func (m *ObjectsDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectsDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectsDeleteRequest proto.InternalMessageInfo
+
+func (m *ObjectsDeleteRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectsDeleteRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectsDeleteRequest) GetEncryptedPaths() [][]byte {
+	if m != nil {
+		return m.EncryptedPaths
+	}
+	return nil
+}
+
+type ObjectsDeleteResponse struct {
+	// items are in the same order as the encrypted paths in the request.
+	Items                []*ObjectsDeleteResponseItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ObjectsDeleteResponse) Reset()         { *m = ObjectsDeleteResponse{} }
+func (m *ObjectsDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectsDeleteResponse) ProtoMessage()    {}
+func (*ObjectsDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{43}
+}
+func (m *ObjectsDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectsDeleteResponse.Unmarshal(m, b)
+}
+func (m *ObjectsDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectsDeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectsDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectsDeleteResponse.Merge(m, src)
+}
+func (m *ObjectsDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectsDeleteResponse.Size(m)
+}
+func (m *ObjectsDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectsDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectsDeleteResponse proto.InternalMessageInfo
+
+func (m *ObjectsDeleteResponse) GetItems() []*ObjectsDeleteResponseItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ObjectsDeleteResponseItem struct {
+	EncryptedPath        []byte                           `protobuf:"bytes,1,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Status               ObjectsDeleteResponseItem_Status `protobuf:"varint,2,opt,name=status,proto3,enum=metainfo.ObjectsDeleteResponseItem_Status" json:"status,omitempty"`
+	Object               *Object                          `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ObjectsDeleteResponseItem) Reset()         { *m = ObjectsDeleteResponseItem{} }
+func (m *ObjectsDeleteResponseItem) String() string { return proto.CompactTextString(m) }
+func (*ObjectsDeleteResponseItem) ProtoMessage()    {}
+func (*ObjectsDeleteResponseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{44}
+}
+func (m *ObjectsDeleteResponseItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectsDeleteResponseItem.Unmarshal(m, b)
+}
+func (m *ObjectsDeleteResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectsDeleteResponseItem.Marshal(b, m, deterministic)
+}
+func (m *ObjectsDeleteResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectsDeleteResponseItem.Merge(m, src)
+}
+func (m *ObjectsDeleteResponseItem) XXX_Size() int {
+	return xxx_messageInfo_ObjectsDeleteResponseItem.Size(m)
+}
+func (m *ObjectsDeleteResponseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectsDeleteResponseItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectsDeleteResponseItem proto.InternalMessageInfo
+
+func (m *ObjectsDeleteResponseItem) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectsDeleteResponseItem) GetStatus() ObjectsDeleteResponseItem_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ObjectsDeleteResponseItem_INVALID
+}
+
+func (m *ObjectsDeleteResponseItem) GetObject() *Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+// ObjectPurgeVersionsRequest deletes versions of an object,
+// which are not the latest version.
+type ObjectPurgeVersionsRequest struct {
+	Header        *RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket        []byte         `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath []byte         `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	// created_before limits purging to non-latest versions created before
+	// the timestamp.
+	CreatedBefore *time.Time `protobuf:"bytes,3,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
+	// all_versions purges every version except the latest. It must be set
+	// explicitly, a request with neither created_before nor all_versions
+	// is rejected.
+	AllVersions          bool     `protobuf:"varint,4,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectPurgeVersionsRequest) Reset()         { *m = ObjectPurgeVersionsRequest{} }
+func (m *ObjectPurgeVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectPurgeVersionsRequest) ProtoMessage()    {}
+func (*ObjectPurgeVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{45}
+}
+func (m *ObjectPurgeVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectPurgeVersionsRequest.Unmarshal(m, b)
+}
+func (m *ObjectPurgeVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectPurgeVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectPurgeVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectPurgeVersionsRequest.Merge(m, src)
+}
+func (m *ObjectPurgeVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectPurgeVersionsRequest.Size(m)
+}
+func (m *ObjectPurgeVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectPurgeVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectPurgeVersionsRequest proto.InternalMessageInfo
+
+func (m *ObjectPurgeVersionsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectPurgeVersionsRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectPurgeVersionsRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *ObjectPurgeVersionsRequest) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ObjectPurgeVersionsRequest) GetAllVersions() bool {
+	if m != nil {
+		return m.AllVersions
+	}
+	return false
+}
+
+type ObjectPurgeVersionsResponse struct {
+	DeletedVersionsCount int64    `protobuf:"varint,1,opt,name=deleted_versions_count,json=deletedVersionsCount,proto3" json:"deleted_versions_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectPurgeVersionsResponse) Reset()         { *m = ObjectPurgeVersionsResponse{} }
+func (m *ObjectPurgeVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectPurgeVersionsResponse) ProtoMessage()    {}
+func (*ObjectPurgeVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{46}
+}
+func (m *ObjectPurgeVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectPurgeVersionsResponse.Unmarshal(m, b)
+}
+func (m *ObjectPurgeVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectPurgeVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectPurgeVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectPurgeVersionsResponse.Merge(m, src)
+}
+func (m *ObjectPurgeVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectPurgeVersionsResponse.Size(m)
+}
+func (m *ObjectPurgeVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectPurgeVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectPurgeVersionsResponse proto.InternalMessageInfo
+
+func (m *ObjectPurgeVersionsResponse) GetDeletedVersionsCount() int64 {
+	if m != nil {
+		return m.DeletedVersionsCount
+	}
+	return 0
+}
+
 type ObjectGetIPsRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
 	Bucket               []byte         `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
@@ -2390,7 +2956,7 @@ func (m *ObjectGetIPsRequest) Reset()         { *m = ObjectGetIPsRequest{} }
 func (m *ObjectGetIPsRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectGetIPsRequest) ProtoMessage()    {}
 func (*ObjectGetIPsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{39}
+	return fileDescriptor_631e2f30a93cd64e, []int{47}
 }
 func (m *ObjectGetIPsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectGetIPsRequest.Unmarshal(m, b)
@@ -2452,7 +3018,7 @@ func (m *ObjectGetIPsResponse) Reset()         { *m = ObjectGetIPsResponse{} }
 func (m *ObjectGetIPsResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectGetIPsResponse) ProtoMessage()    {}
 func (*ObjectGetIPsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{40}
+	return fileDescriptor_631e2f30a93cd64e, []int{48}
 }
 func (m *ObjectGetIPsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectGetIPsResponse.Unmarshal(m, b)
@@ -2518,7 +3084,7 @@ func (m *ObjectUpdateMetadataRequest) Reset()         { *m = ObjectUpdateMetadat
 func (m *ObjectUpdateMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectUpdateMetadataRequest) ProtoMessage()    {}
 func (*ObjectUpdateMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{41}
+	return fileDescriptor_631e2f30a93cd64e, []int{49}
 }
 func (m *ObjectUpdateMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectUpdateMetadataRequest.Unmarshal(m, b)
@@ -2590,7 +3156,7 @@ func (m *ObjectUpdateMetadataResponse) Reset()         { *m = ObjectUpdateMetada
 func (m *ObjectUpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectUpdateMetadataResponse) ProtoMessage()    {}
 func (*ObjectUpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{42}
+	return fileDescriptor_631e2f30a93cd64e, []int{50}
 }
 func (m *ObjectUpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectUpdateMetadataResponse.Unmarshal(m, b)
@@ -2610,7 +3176,6 @@ func (m *ObjectUpdateMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObjectUpdateMetadataResponse proto.InternalMessageInfo
 
-//
 // Only for satellite use
 //
 // TODO this needs to be removed BUT unfortunately libuplink is using it and
@@ -2626,7 +3191,7 @@ func (m *SatStreamID) Reset()         { *m = SatStreamID{} }
 func (m *SatStreamID) String() string { return proto.CompactTextString(m) }
 func (*SatStreamID) ProtoMessage()    {}
 func (*SatStreamID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{43}
+	return fileDescriptor_631e2f30a93cd64e, []int{51}
 }
 func (m *SatStreamID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatStreamID.Unmarshal(m, b)
@@ -2670,7 +3235,7 @@ func (m *Segment) Reset()         { *m = Segment{} }
 func (m *Segment) String() string { return proto.CompactTextString(m) }
 func (*Segment) ProtoMessage()    {}
 func (*Segment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{44}
+	return fileDescriptor_631e2f30a93cd64e, []int{52}
 }
 func (m *Segment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Segment.Unmarshal(m, b)
@@ -2737,7 +3302,7 @@ func (m *Piece) Reset()         { *m = Piece{} }
 func (m *Piece) String() string { return proto.CompactTextString(m) }
 func (*Piece) ProtoMessage()    {}
 func (*Piece) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{45}
+	return fileDescriptor_631e2f30a93cd64e, []int{53}
 }
 func (m *Piece) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Piece.Unmarshal(m, b)
@@ -2776,7 +3341,7 @@ func (m *SegmentPosition) Reset()         { *m = SegmentPosition{} }
 func (m *SegmentPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentPosition) ProtoMessage()    {}
 func (*SegmentPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{46}
+	return fileDescriptor_631e2f30a93cd64e, []int{54}
 }
 func (m *SegmentPosition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentPosition.Unmarshal(m, b)
@@ -2824,7 +3389,7 @@ func (m *SegmentBeginRequest) Reset()         { *m = SegmentBeginRequest{} }
 func (m *SegmentBeginRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentBeginRequest) ProtoMessage()    {}
 func (*SegmentBeginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{47}
+	return fileDescriptor_631e2f30a93cd64e, []int{55}
 }
 func (m *SegmentBeginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentBeginRequest.Unmarshal(m, b)
@@ -2879,7 +3444,7 @@ func (m *SegmentBeginResponse) Reset()         { *m = SegmentBeginResponse{} }
 func (m *SegmentBeginResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentBeginResponse) ProtoMessage()    {}
 func (*SegmentBeginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{48}
+	return fileDescriptor_631e2f30a93cd64e, []int{56}
 }
 func (m *SegmentBeginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentBeginResponse.Unmarshal(m, b)
@@ -2931,7 +3496,7 @@ func (m *SegmentCommitRequest) Reset()         { *m = SegmentCommitRequest{} }
 func (m *SegmentCommitRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitRequest) ProtoMessage()    {}
 func (*SegmentCommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{49}
+	return fileDescriptor_631e2f30a93cd64e, []int{57}
 }
 func (m *SegmentCommitRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitRequest.Unmarshal(m, b)
@@ -3006,7 +3571,7 @@ func (m *SegmentPieceUploadResult) Reset()         { *m = SegmentPieceUploadResu
 func (m *SegmentPieceUploadResult) String() string { return proto.CompactTextString(m) }
 func (*SegmentPieceUploadResult) ProtoMessage()    {}
 func (*SegmentPieceUploadResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{50}
+	return fileDescriptor_631e2f30a93cd64e, []int{58}
 }
 func (m *SegmentPieceUploadResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentPieceUploadResult.Unmarshal(m, b)
@@ -3051,7 +3616,7 @@ func (m *SegmentCommitResponse) Reset()         { *m = SegmentCommitResponse{} }
 func (m *SegmentCommitResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentCommitResponse) ProtoMessage()    {}
 func (*SegmentCommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{51}
+	return fileDescriptor_631e2f30a93cd64e, []int{59}
 }
 func (m *SegmentCommitResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentCommitResponse.Unmarshal(m, b)
@@ -3096,7 +3661,7 @@ func (m *SegmentMakeInlineRequest) Reset()         { *m = SegmentMakeInlineReque
 func (m *SegmentMakeInlineRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentMakeInlineRequest) ProtoMessage()    {}
 func (*SegmentMakeInlineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{52}
+	return fileDescriptor_631e2f30a93cd64e, []int{60}
 }
 func (m *SegmentMakeInlineRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentMakeInlineRequest.Unmarshal(m, b)
@@ -3168,7 +3733,7 @@ func (m *SegmentMakeInlineResponse) Reset()         { *m = SegmentMakeInlineResp
 func (m *SegmentMakeInlineResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentMakeInlineResponse) ProtoMessage()    {}
 func (*SegmentMakeInlineResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{53}
+	return fileDescriptor_631e2f30a93cd64e, []int{61}
 }
 func (m *SegmentMakeInlineResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentMakeInlineResponse.Unmarshal(m, b)
@@ -3201,7 +3766,7 @@ func (m *SegmentBeginDeleteRequest) Reset()         { *m = SegmentBeginDeleteReq
 func (m *SegmentBeginDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentBeginDeleteRequest) ProtoMessage()    {}
 func (*SegmentBeginDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{54}
+	return fileDescriptor_631e2f30a93cd64e, []int{62}
 }
 func (m *SegmentBeginDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentBeginDeleteRequest.Unmarshal(m, b)
@@ -3248,7 +3813,7 @@ func (m *SegmentBeginDeleteResponse) Reset()         { *m = SegmentBeginDeleteRe
 func (m *SegmentBeginDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentBeginDeleteResponse) ProtoMessage()    {}
 func (*SegmentBeginDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{55}
+	return fileDescriptor_631e2f30a93cd64e, []int{63}
 }
 func (m *SegmentBeginDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentBeginDeleteResponse.Unmarshal(m, b)
@@ -3288,7 +3853,7 @@ func (m *SegmentFinishDeleteRequest) Reset()         { *m = SegmentFinishDeleteR
 func (m *SegmentFinishDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentFinishDeleteRequest) ProtoMessage()    {}
 func (*SegmentFinishDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{56}
+	return fileDescriptor_631e2f30a93cd64e, []int{64}
 }
 func (m *SegmentFinishDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentFinishDeleteRequest.Unmarshal(m, b)
@@ -3335,7 +3900,7 @@ func (m *SegmentPieceDeleteResult) Reset()         { *m = SegmentPieceDeleteResu
 func (m *SegmentPieceDeleteResult) String() string { return proto.CompactTextString(m) }
 func (*SegmentPieceDeleteResult) ProtoMessage()    {}
 func (*SegmentPieceDeleteResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{57}
+	return fileDescriptor_631e2f30a93cd64e, []int{65}
 }
 func (m *SegmentPieceDeleteResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentPieceDeleteResult.Unmarshal(m, b)
@@ -3379,7 +3944,7 @@ func (m *SegmentFinishDeleteResponse) Reset()         { *m = SegmentFinishDelete
 func (m *SegmentFinishDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentFinishDeleteResponse) ProtoMessage()    {}
 func (*SegmentFinishDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{58}
+	return fileDescriptor_631e2f30a93cd64e, []int{66}
 }
 func (m *SegmentFinishDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentFinishDeleteResponse.Unmarshal(m, b)
@@ -3416,7 +3981,7 @@ func (m *SegmentListRequest) Reset()         { *m = SegmentListRequest{} }
 func (m *SegmentListRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentListRequest) ProtoMessage()    {}
 func (*SegmentListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{59}
+	return fileDescriptor_631e2f30a93cd64e, []int{67}
 }
 func (m *SegmentListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentListRequest.Unmarshal(m, b)
@@ -3465,8 +4030,10 @@ func (m *SegmentListRequest) GetRange() *Range {
 }
 
 type SegmentListResponse struct {
-	Items                []*SegmentListItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	More                 bool                  `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	Items []*SegmentListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// When streaming, more is only set in the last message of the stream.
+	More bool `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	// When streaming, encryption_parameters is only set in the first message of the stream.
 	EncryptionParameters *EncryptionParameters `protobuf:"bytes,3,opt,name=encryption_parameters,json=encryptionParameters,proto3" json:"encryption_parameters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -3477,7 +4044,7 @@ func (m *SegmentListResponse) Reset()         { *m = SegmentListResponse{} }
 func (m *SegmentListResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentListResponse) ProtoMessage()    {}
 func (*SegmentListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{60}
+	return fileDescriptor_631e2f30a93cd64e, []int{68}
 }
 func (m *SegmentListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentListResponse.Unmarshal(m, b)
@@ -3537,7 +4104,7 @@ func (m *SegmentListItem) Reset()         { *m = SegmentListItem{} }
 func (m *SegmentListItem) String() string { return proto.CompactTextString(m) }
 func (*SegmentListItem) ProtoMessage()    {}
 func (*SegmentListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{61}
+	return fileDescriptor_631e2f30a93cd64e, []int{69}
 }
 func (m *SegmentListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentListItem.Unmarshal(m, b)
@@ -3612,7 +4179,7 @@ func (m *SegmentDownloadRequest) Reset()         { *m = SegmentDownloadRequest{}
 func (m *SegmentDownloadRequest) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadRequest) ProtoMessage()    {}
 func (*SegmentDownloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{62}
+	return fileDescriptor_631e2f30a93cd64e, []int{70}
 }
 func (m *SegmentDownloadRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadRequest.Unmarshal(m, b)
@@ -3672,7 +4239,7 @@ func (m *SegmentDownloadResponse) Reset()         { *m = SegmentDownloadResponse
 func (m *SegmentDownloadResponse) String() string { return proto.CompactTextString(m) }
 func (*SegmentDownloadResponse) ProtoMessage()    {}
 func (*SegmentDownloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{63}
+	return fileDescriptor_631e2f30a93cd64e, []int{71}
 }
 func (m *SegmentDownloadResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentDownloadResponse.Unmarshal(m, b)
@@ -3768,7 +4335,7 @@ func (m *PartDeleteRequest) Reset()         { *m = PartDeleteRequest{} }
 func (m *PartDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*PartDeleteRequest) ProtoMessage()    {}
 func (*PartDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{64}
+	return fileDescriptor_631e2f30a93cd64e, []int{72}
 }
 func (m *PartDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PartDeleteRequest.Unmarshal(m, b)
@@ -3812,7 +4379,7 @@ func (m *PartDeleteResponse) Reset()         { *m = PartDeleteResponse{} }
 func (m *PartDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*PartDeleteResponse) ProtoMessage()    {}
 func (*PartDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{65}
+	return fileDescriptor_631e2f30a93cd64e, []int{73}
 }
 func (m *PartDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PartDeleteResponse.Unmarshal(m, b)
@@ -3845,7 +4412,7 @@ func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
 func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchRequest) ProtoMessage()    {}
 func (*BatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{66}
+	return fileDescriptor_631e2f30a93cd64e, []int{74}
 }
 func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
@@ -3899,6 +4466,9 @@ type BatchRequestItem struct {
 	//	*BatchRequestItem_ObjectFinishMove
 	//	*BatchRequestItem_ObjectBeginCopy
 	//	*BatchRequestItem_ObjectFinishCopy
+	//	*BatchRequestItem_ObjectsDelete
+	//	*BatchRequestItem_ObjectPurgeVersions
+	//	*BatchRequestItem_BucketSetVersioning
 	//	*BatchRequestItem_SegmentBegin
 	//	*BatchRequestItem_SegmentCommit
 	//	*BatchRequestItem_SegmentMakeInline
@@ -3918,7 +4488,7 @@ func (m *BatchRequestItem) Reset()         { *m = BatchRequestItem{} }
 func (m *BatchRequestItem) String() string { return proto.CompactTextString(m) }
 func (*BatchRequestItem) ProtoMessage()    {}
 func (*BatchRequestItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{67}
+	return fileDescriptor_631e2f30a93cd64e, []int{75}
 }
 func (m *BatchRequestItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchRequestItem.Unmarshal(m, b)
@@ -3996,6 +4566,15 @@ type BatchRequestItem_ObjectBeginCopy struct {
 type BatchRequestItem_ObjectFinishCopy struct {
 	ObjectFinishCopy *ObjectFinishCopyRequest `protobuf:"bytes,29,opt,name=object_finish_copy,json=objectFinishCopy,proto3,oneof" json:"object_finish_copy,omitempty"`
 }
+type BatchRequestItem_ObjectsDelete struct {
+	ObjectsDelete *ObjectsDeleteRequest `protobuf:"bytes,30,opt,name=objects_delete,json=objectsDelete,proto3,oneof" json:"objects_delete,omitempty"`
+}
+type BatchRequestItem_ObjectPurgeVersions struct {
+	ObjectPurgeVersions *ObjectPurgeVersionsRequest `protobuf:"bytes,31,opt,name=object_purge_versions,json=objectPurgeVersions,proto3,oneof" json:"object_purge_versions,omitempty"`
+}
+type BatchRequestItem_BucketSetVersioning struct {
+	BucketSetVersioning *BucketSetVersioningRequest `protobuf:"bytes,32,opt,name=bucket_set_versioning,json=bucketSetVersioning,proto3,oneof" json:"bucket_set_versioning,omitempty"`
+}
 type BatchRequestItem_SegmentBegin struct {
 	SegmentBegin *SegmentBeginRequest `protobuf:"bytes,12,opt,name=segment_begin,json=segmentBegin,proto3,oneof" json:"segment_begin,omitempty"`
 }
@@ -4042,6 +4621,9 @@ func (*BatchRequestItem_ObjectBeginMove) isBatchRequestItem_Request()          {
 func (*BatchRequestItem_ObjectFinishMove) isBatchRequestItem_Request()         {}
 func (*BatchRequestItem_ObjectBeginCopy) isBatchRequestItem_Request()          {}
 func (*BatchRequestItem_ObjectFinishCopy) isBatchRequestItem_Request()         {}
+func (*BatchRequestItem_ObjectsDelete) isBatchRequestItem_Request()            {}
+func (*BatchRequestItem_ObjectPurgeVersions) isBatchRequestItem_Request()      {}
+func (*BatchRequestItem_BucketSetVersioning) isBatchRequestItem_Request()      {}
 func (*BatchRequestItem_SegmentBegin) isBatchRequestItem_Request()             {}
 func (*BatchRequestItem_SegmentCommit) isBatchRequestItem_Request()            {}
 func (*BatchRequestItem_SegmentMakeInline) isBatchRequestItem_Request()        {}
@@ -4185,6 +4767,27 @@ func (m *BatchRequestItem) GetObjectFinishCopy() *ObjectFinishCopyRequest {
 	return nil
 }
 
+func (m *BatchRequestItem) GetObjectsDelete() *ObjectsDeleteRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectsDelete); ok {
+		return x.ObjectsDelete
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetObjectPurgeVersions() *ObjectPurgeVersionsRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_ObjectPurgeVersions); ok {
+		return x.ObjectPurgeVersions
+	}
+	return nil
+}
+
+func (m *BatchRequestItem) GetBucketSetVersioning() *BucketSetVersioningRequest {
+	if x, ok := m.GetRequest().(*BatchRequestItem_BucketSetVersioning); ok {
+		return x.BucketSetVersioning
+	}
+	return nil
+}
+
 func (m *BatchRequestItem) GetSegmentBegin() *SegmentBeginRequest {
 	if x, ok := m.GetRequest().(*BatchRequestItem_SegmentBegin); ok {
 		return x.SegmentBegin
@@ -4269,6 +4872,9 @@ func (*BatchRequestItem) XXX_OneofWrappers() []interface{} {
 		(*BatchRequestItem_ObjectFinishMove)(nil),
 		(*BatchRequestItem_ObjectBeginCopy)(nil),
 		(*BatchRequestItem_ObjectFinishCopy)(nil),
+		(*BatchRequestItem_ObjectsDelete)(nil),
+		(*BatchRequestItem_ObjectPurgeVersions)(nil),
+		(*BatchRequestItem_BucketSetVersioning)(nil),
 		(*BatchRequestItem_SegmentBegin)(nil),
 		(*BatchRequestItem_SegmentCommit)(nil),
 		(*BatchRequestItem_SegmentMakeInline)(nil),
@@ -4292,7 +4898,7 @@ func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
 func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchResponse) ProtoMessage()    {}
 func (*BatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{68}
+	return fileDescriptor_631e2f30a93cd64e, []int{76}
 }
 func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchResponse.Unmarshal(m, b)
@@ -4339,6 +4945,9 @@ type BatchResponseItem struct {
 	//	*BatchResponseItem_ObjectFinishMove
 	//	*BatchResponseItem_ObjectBeginCopy
 	//	*BatchResponseItem_ObjectFinishCopy
+	//	*BatchResponseItem_ObjectsDelete
+	//	*BatchResponseItem_ObjectPurgeVersions
+	//	*BatchResponseItem_BucketSetVersioning
 	//	*BatchResponseItem_SegmentBegin
 	//	*BatchResponseItem_SegmentCommit
 	//	*BatchResponseItem_SegmentMakeInline
@@ -4358,7 +4967,7 @@ func (m *BatchResponseItem) Reset()         { *m = BatchResponseItem{} }
 func (m *BatchResponseItem) String() string { return proto.CompactTextString(m) }
 func (*BatchResponseItem) ProtoMessage()    {}
 func (*BatchResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{69}
+	return fileDescriptor_631e2f30a93cd64e, []int{77}
 }
 func (m *BatchResponseItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchResponseItem.Unmarshal(m, b)
@@ -4436,6 +5045,15 @@ type BatchResponseItem_ObjectBeginCopy struct {
 type BatchResponseItem_ObjectFinishCopy struct {
 	ObjectFinishCopy *ObjectFinishCopyResponse `protobuf:"bytes,29,opt,name=object_finish_copy,json=objectFinishCopy,proto3,oneof" json:"object_finish_copy,omitempty"`
 }
+type BatchResponseItem_ObjectsDelete struct {
+	ObjectsDelete *ObjectsDeleteResponse `protobuf:"bytes,30,opt,name=objects_delete,json=objectsDelete,proto3,oneof" json:"objects_delete,omitempty"`
+}
+type BatchResponseItem_ObjectPurgeVersions struct {
+	ObjectPurgeVersions *ObjectPurgeVersionsResponse `protobuf:"bytes,31,opt,name=object_purge_versions,json=objectPurgeVersions,proto3,oneof" json:"object_purge_versions,omitempty"`
+}
+type BatchResponseItem_BucketSetVersioning struct {
+	BucketSetVersioning *BucketSetVersioningResponse `protobuf:"bytes,32,opt,name=bucket_set_versioning,json=bucketSetVersioning,proto3,oneof" json:"bucket_set_versioning,omitempty"`
+}
 type BatchResponseItem_SegmentBegin struct {
 	SegmentBegin *SegmentBeginResponse `protobuf:"bytes,12,opt,name=segment_begin,json=segmentBegin,proto3,oneof" json:"segment_begin,omitempty"`
 }
@@ -4482,6 +5100,9 @@ func (*BatchResponseItem_ObjectBeginMove) isBatchResponseItem_Response()
 func (*BatchResponseItem_ObjectFinishMove) isBatchResponseItem_Response()         {}
 func (*BatchResponseItem_ObjectBeginCopy) isBatchResponseItem_Response()          {}
 func (*BatchResponseItem_ObjectFinishCopy) isBatchResponseItem_Response()         {}
+func (*BatchResponseItem_ObjectsDelete) isBatchResponseItem_Response()            {}
+func (*BatchResponseItem_ObjectPurgeVersions) isBatchResponseItem_Response()      {}
+func (*BatchResponseItem_BucketSetVersioning) isBatchResponseItem_Response()      {}
 func (*BatchResponseItem_SegmentBegin) isBatchResponseItem_Response()             {}
 func (*BatchResponseItem_SegmentCommit) isBatchResponseItem_Response()            {}
 func (*BatchResponseItem_SegmentMakeInline) isBatchResponseItem_Response()        {}
@@ -4625,6 +5246,27 @@ func (m *BatchResponseItem) GetObjectFinishCopy() *ObjectFinishCopyResponse {
 	return nil
 }
 
+func (m *BatchResponseItem) GetObjectsDelete() *ObjectsDeleteResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectsDelete); ok {
+		return x.ObjectsDelete
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetObjectPurgeVersions() *ObjectPurgeVersionsResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_ObjectPurgeVersions); ok {
+		return x.ObjectPurgeVersions
+	}
+	return nil
+}
+
+func (m *BatchResponseItem) GetBucketSetVersioning() *BucketSetVersioningResponse {
+	if x, ok := m.GetResponse().(*BatchResponseItem_BucketSetVersioning); ok {
+		return x.BucketSetVersioning
+	}
+	return nil
+}
+
 func (m *BatchResponseItem) GetSegmentBegin() *SegmentBeginResponse {
 	if x, ok := m.GetResponse().(*BatchResponseItem_SegmentBegin); ok {
 		return x.SegmentBegin
@@ -4709,6 +5351,9 @@ func (*BatchResponseItem) XXX_OneofWrappers() []interface{} {
 		(*BatchResponseItem_ObjectFinishMove)(nil),
 		(*BatchResponseItem_ObjectBeginCopy)(nil),
 		(*BatchResponseItem_ObjectFinishCopy)(nil),
+		(*BatchResponseItem_ObjectsDelete)(nil),
+		(*BatchResponseItem_ObjectPurgeVersions)(nil),
+		(*BatchResponseItem_BucketSetVersioning)(nil),
 		(*BatchResponseItem_SegmentBegin)(nil),
 		(*BatchResponseItem_SegmentCommit)(nil),
 		(*BatchResponseItem_SegmentMakeInline)(nil),
@@ -4733,7 +5378,7 @@ func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
 func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*RevokeAPIKeyRequest) ProtoMessage()    {}
 func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{70}
+	return fileDescriptor_631e2f30a93cd64e, []int{78}
 }
 func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
@@ -4777,7 +5422,7 @@ func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
 func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*RevokeAPIKeyResponse) ProtoMessage()    {}
 func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{71}
+	return fileDescriptor_631e2f30a93cd64e, []int{79}
 }
 func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
@@ -4814,7 +5459,7 @@ func (m *ObjectBeginMoveRequest) Reset()         { *m = ObjectBeginMoveRequest{}
 func (m *ObjectBeginMoveRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginMoveRequest) ProtoMessage()    {}
 func (*ObjectBeginMoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{72}
+	return fileDescriptor_631e2f30a93cd64e, []int{80}
 }
 func (m *ObjectBeginMoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginMoveRequest.Unmarshal(m, b)
@@ -4884,7 +5529,7 @@ func (m *ObjectBeginMoveResponse) Reset()         { *m = ObjectBeginMoveResponse
 func (m *ObjectBeginMoveResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginMoveResponse) ProtoMessage()    {}
 func (*ObjectBeginMoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{73}
+	return fileDescriptor_631e2f30a93cd64e, []int{81}
 }
 func (m *ObjectBeginMoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginMoveResponse.Unmarshal(m, b)
@@ -4942,7 +5587,7 @@ func (m *ObjectFinishMoveRequest) Reset()         { *m = ObjectFinishMoveRequest
 func (m *ObjectFinishMoveRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishMoveRequest) ProtoMessage()    {}
 func (*ObjectFinishMoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{74}
+	return fileDescriptor_631e2f30a93cd64e, []int{82}
 }
 func (m *ObjectFinishMoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishMoveRequest.Unmarshal(m, b)
@@ -5007,7 +5652,7 @@ func (m *ObjectFinishMoveResponse) Reset()         { *m = ObjectFinishMoveRespon
 func (m *ObjectFinishMoveResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishMoveResponse) ProtoMessage()    {}
 func (*ObjectFinishMoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{75}
+	return fileDescriptor_631e2f30a93cd64e, []int{83}
 }
 func (m *ObjectFinishMoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishMoveResponse.Unmarshal(m, b)
@@ -5044,7 +5689,7 @@ func (m *ObjectBeginCopyRequest) Reset()         { *m = ObjectBeginCopyRequest{}
 func (m *ObjectBeginCopyRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginCopyRequest) ProtoMessage()    {}
 func (*ObjectBeginCopyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{76}
+	return fileDescriptor_631e2f30a93cd64e, []int{84}
 }
 func (m *ObjectBeginCopyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginCopyRequest.Unmarshal(m, b)
@@ -5116,7 +5761,7 @@ func (m *ObjectBeginCopyResponse) Reset()         { *m = ObjectBeginCopyResponse
 func (m *ObjectBeginCopyResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectBeginCopyResponse) ProtoMessage()    {}
 func (*ObjectBeginCopyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{77}
+	return fileDescriptor_631e2f30a93cd64e, []int{85}
 }
 func (m *ObjectBeginCopyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectBeginCopyResponse.Unmarshal(m, b)
@@ -5181,7 +5826,7 @@ func (m *ObjectFinishCopyRequest) Reset()         { *m = ObjectFinishCopyRequest
 func (m *ObjectFinishCopyRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishCopyRequest) ProtoMessage()    {}
 func (*ObjectFinishCopyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{78}
+	return fileDescriptor_631e2f30a93cd64e, []int{86}
 }
 func (m *ObjectFinishCopyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishCopyRequest.Unmarshal(m, b)
@@ -5261,7 +5906,7 @@ func (m *ObjectFinishCopyResponse) Reset()         { *m = ObjectFinishCopyRespon
 func (m *ObjectFinishCopyResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectFinishCopyResponse) ProtoMessage()    {}
 func (*ObjectFinishCopyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{79}
+	return fileDescriptor_631e2f30a93cd64e, []int{87}
 }
 func (m *ObjectFinishCopyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ObjectFinishCopyResponse.Unmarshal(m, b)
@@ -5301,7 +5946,7 @@ func (m *EncryptedKeyAndNonce) Reset()         { *m = EncryptedKeyAndNonce{} }
 func (m *EncryptedKeyAndNonce) String() string { return proto.CompactTextString(m) }
 func (*EncryptedKeyAndNonce) ProtoMessage()    {}
 func (*EncryptedKeyAndNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631e2f30a93cd64e, []int{80}
+	return fileDescriptor_631e2f30a93cd64e, []int{88}
 }
 func (m *EncryptedKeyAndNonce) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EncryptedKeyAndNonce.Unmarshal(m, b)
@@ -5336,9 +5981,12 @@ func (m *EncryptedKeyAndNonce) GetEncryptedKey() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("metainfo.Versioning", Versioning_name, Versioning_value)
 	proto.RegisterEnum("metainfo.Object_Status", Object_Status_name, Object_Status_value)
+	proto.RegisterEnum("metainfo.ObjectsDeleteResponseItem_Status", ObjectsDeleteResponseItem_Status_name, ObjectsDeleteResponseItem_Status_value)
 	proto.RegisterType((*RequestHeader)(nil), "metainfo.RequestHeader")
 	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
+	proto.RegisterType((*ObjectLock)(nil), "metainfo.ObjectLock")
 	proto.RegisterType((*BucketListItem)(nil), "metainfo.BucketListItem")
 	proto.RegisterType((*BucketCreateRequest)(nil), "metainfo.BucketCreateRequest")
 	proto.RegisterType((*BucketCreateResponse)(nil), "metainfo.BucketCreateResponse")
@@ -5350,6 +5998,8 @@ func init() {
 	proto.RegisterType((*BucketListResponse)(nil), "metainfo.BucketListResponse")
 	proto.RegisterType((*BucketSetAttributionRequest)(nil), "metainfo.BucketSetAttributionRequest")
 	proto.RegisterType((*BucketSetAttributionResponse)(nil), "metainfo.BucketSetAttributionResponse")
+	proto.RegisterType((*BucketSetVersioningRequest)(nil), "metainfo.BucketSetVersioningRequest")
+	proto.RegisterType((*BucketSetVersioningResponse)(nil), "metainfo.BucketSetVersioningResponse")
 	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
 	proto.RegisterType((*ProjectInfoRequest)(nil), "metainfo.ProjectInfoRequest")
 	proto.RegisterType((*ProjectInfoResponse)(nil), "metainfo.ProjectInfoResponse")
@@ -5376,6 +6026,11 @@ func init() {
 	proto.RegisterType((*ObjectBeginDeleteResponse)(nil), "metainfo.ObjectBeginDeleteResponse")
 	proto.RegisterType((*ObjectFinishDeleteRequest)(nil), "metainfo.ObjectFinishDeleteRequest")
 	proto.RegisterType((*ObjectFinishDeleteResponse)(nil), "metainfo.ObjectFinishDeleteResponse")
+	proto.RegisterType((*ObjectsDeleteRequest)(nil), "metainfo.ObjectsDeleteRequest")
+	proto.RegisterType((*ObjectsDeleteResponse)(nil), "metainfo.ObjectsDeleteResponse")
+	proto.RegisterType((*ObjectsDeleteResponseItem)(nil), "metainfo.ObjectsDeleteResponseItem")
+	proto.RegisterType((*ObjectPurgeVersionsRequest)(nil), "metainfo.ObjectPurgeVersionsRequest")
+	proto.RegisterType((*ObjectPurgeVersionsResponse)(nil), "metainfo.ObjectPurgeVersionsResponse")
 	proto.RegisterType((*ObjectGetIPsRequest)(nil), "metainfo.ObjectGetIPsRequest")
 	proto.RegisterType((*ObjectGetIPsResponse)(nil), "metainfo.ObjectGetIPsResponse")
 	proto.RegisterType((*ObjectUpdateMetadataRequest)(nil), "metainfo.ObjectUpdateMetadataRequest")
@@ -5423,290 +6078,327 @@ func init() {
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 4556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1c, 0xd9,
-	0x71, 0xe6, 0x70, 0x7e, 0x38, 0xac, 0x19, 0x92, 0x33, 0x8f, 0x23, 0x72, 0xd4, 0x24, 0x25, 0x6e,
-	0x6b, 0xb5, 0xd6, 0xc6, 0x5e, 0x4a, 0x50, 0x1c, 0x67, 0x03, 0xef, 0x66, 0x4d, 0x8a, 0x5c, 0xce,
-	0xac, 0x24, 0x92, 0x6e, 0x4a, 0x5e, 0xc5, 0xf9, 0x69, 0x34, 0x67, 0x1e, 0xc9, 0x5e, 0xcd, 0x74,
-	0x8f, 0xbb, 0x7b, 0x24, 0xd1, 0x39, 0x05, 0x08, 0x90, 0x5c, 0x02, 0x2c, 0x8c, 0x20, 0xb7, 0x20,
-	0x41, 0x90, 0x5b, 0x10, 0x04, 0xf6, 0x39, 0xc9, 0x2d, 0x40, 0x6e, 0x46, 0x8c, 0x9c, 0x1c, 0xc0,
-	0xce, 0x31, 0x80, 0x4f, 0x39, 0xe4, 0x16, 0x20, 0xc1, 0xfb, 0xeb, 0xdf, 0xd7, 0x3d, 0x33, 0xe4,
-	0x48, 0xde, 0x45, 0x72, 0x63, 0xbf, 0xaa, 0x57, 0x5d, 0x5d, 0xaf, 0x5e, 0xbd, 0xaf, 0xaa, 0x1e,
-	0x07, 0x16, 0xfb, 0xd8, 0x33, 0x4c, 0xeb, 0xd4, 0xde, 0x1a, 0x38, 0xb6, 0x67, 0xa3, 0xb2, 0x78,
-	0x56, 0x6a, 0xd8, 0xea, 0x38, 0x17, 0x03, 0xcf, 0xb4, 0x2d, 0x46, 0x53, 0xe0, 0xcc, 0x3e, 0xe3,
-	0x7c, 0xca, 0xcd, 0x33, 0xdb, 0x3e, 0xeb, 0xe1, 0xbb, 0xf4, 0xe9, 0x64, 0x78, 0x7a, 0xd7, 0x33,
-	0xfb, 0xd8, 0xf5, 0x8c, 0xfe, 0x40, 0x30, 0x5b, 0x76, 0x17, 0xf3, 0xbf, 0x97, 0x06, 0xb6, 0x69,
-	0x79, 0xd8, 0xe9, 0x9e, 0xf0, 0x81, 0xaa, 0xed, 0x74, 0xb1, 0xe3, 0xb2, 0x27, 0x75, 0x1f, 0x16,
-	0x34, 0xfc, 0xbd, 0x21, 0x76, 0xbd, 0x16, 0x36, 0xba, 0xd8, 0x41, 0xab, 0x30, 0x67, 0x0c, 0x4c,
-	0xfd, 0x39, 0xbe, 0x68, 0xe6, 0x36, 0x73, 0x77, 0xaa, 0x5a, 0xc9, 0x18, 0x98, 0x0f, 0xf1, 0x05,
-	0xda, 0x00, 0x18, 0xba, 0xd8, 0xd1, 0x8d, 0x33, 0x6c, 0x79, 0xcd, 0x59, 0x4a, 0x9b, 0x27, 0x23,
-	0xdb, 0x64, 0x40, 0xfd, 0x9b, 0x3c, 0x94, 0x76, 0x86, 0x9d, 0xe7, 0xd8, 0x43, 0x08, 0x0a, 0x96,
-	0xd1, 0xc7, 0x7c, 0x3e, 0xfd, 0x1b, 0xbd, 0x0f, 0x95, 0x81, 0xe1, 0x9d, 0xeb, 0x1d, 0x73, 0x70,
-	0x8e, 0x1d, 0x3a, 0x7d, 0xf1, 0xfe, 0xea, 0x56, 0xe8, 0x3b, 0x1f, 0x50, 0xca, 0xf1, 0xd0, 0xf4,
-	0xb0, 0x06, 0x84, 0x97, 0x0d, 0xa0, 0x07, 0x00, 0x1d, 0x07, 0x1b, 0x1e, 0xee, 0xea, 0x86, 0xd7,
-	0xcc, 0x6f, 0xe6, 0xee, 0x54, 0xee, 0x2b, 0x5b, 0xcc, 0x04, 0x5b, 0xc2, 0x04, 0x5b, 0x4f, 0x84,
-	0x09, 0x76, 0xca, 0xff, 0xfc, 0xb3, 0x9b, 0x33, 0x9f, 0xff, 0xfc, 0x66, 0x4e, 0x9b, 0xe7, 0xf3,
-	0xb6, 0x3d, 0x74, 0x0f, 0x1a, 0x5d, 0x7c, 0x6a, 0x0c, 0x7b, 0x9e, 0xee, 0xe2, 0xb3, 0x3e, 0xb6,
-	0x3c, 0xdd, 0x35, 0xbf, 0x8f, 0x9b, 0x85, 0xcd, 0xdc, 0x9d, 0xbc, 0x86, 0x38, 0xed, 0x98, 0x91,
-	0x8e, 0xcd, 0xef, 0x63, 0xf4, 0x29, 0x5c, 0x17, 0x33, 0x1c, 0xdc, 0x1d, 0x5a, 0x5d, 0xc3, 0xea,
-	0x5c, 0xe8, 0x6e, 0xe7, 0x1c, 0xf7, 0x71, 0xb3, 0x48, 0xb5, 0x58, 0xdb, 0x0a, 0x6c, 0xab, 0xf9,
-	0x3c, 0xc7, 0x94, 0x45, 0x5b, 0xe5, 0xb3, 0xe3, 0x04, 0xd4, 0x85, 0x0d, 0x21, 0x38, 0xf8, 0x7a,
-	0x7d, 0x60, 0x38, 0x46, 0x1f, 0x7b, 0xd8, 0x71, 0x9b, 0x25, 0x2a, 0x7c, 0x33, 0x6c, 0x9b, 0x3d,
-	0xff, 0xcf, 0x23, 0x9f, 0x4f, 0x5b, 0xe3, 0x62, 0x64, 0x44, 0xb2, 0x5a, 0x03, 0xc3, 0xf1, 0x2c,
-	0xec, 0xe8, 0x66, 0xb7, 0x39, 0xc7, 0x56, 0x8b, 0x8f, 0xb4, 0xbb, 0xea, 0x1f, 0xe7, 0x60, 0x91,
-	0xad, 0xd6, 0x23, 0xd3, 0xf5, 0xda, 0x1e, 0xee, 0x4b, 0x57, 0x2d, 0xba, 0xe6, 0xf9, 0xd8, 0x9a,
-	0xc7, 0x96, 0x66, 0xf6, 0x52, 0x4b, 0xa3, 0xfe, 0x75, 0x1e, 0x96, 0x99, 0x2a, 0x0f, 0xe8, 0x18,
-	0x77, 0x47, 0x74, 0x17, 0x4a, 0xe7, 0xd4, 0x25, 0x9b, 0x4b, 0x54, 0xf0, 0xea, 0x96, 0xbf, 0x5d,
-	0x22, 0x1e, 0xab, 0x71, 0xb6, 0x29, 0xbb, 0x5d, 0x9a, 0xc7, 0xe4, 0x2f, 0xe7, 0x31, 0x85, 0xd7,
-	0xe9, 0x31, 0xc5, 0xe9, 0x7b, 0x4c, 0x29, 0xee, 0x31, 0xdf, 0x82, 0x46, 0x74, 0x95, 0xdc, 0x81,
-	0x6d, 0xb9, 0x18, 0xdd, 0x81, 0xd2, 0x09, 0x1d, 0xa7, 0x76, 0xaf, 0xdc, 0xaf, 0x05, 0xcb, 0xc4,
-	0xf8, 0x35, 0x4e, 0x57, 0x3f, 0x85, 0x1a, 0x1b, 0xd9, 0xc7, 0xde, 0x34, 0x17, 0x59, 0xfd, 0x10,
-	0xea, 0x21, 0xc1, 0x13, 0xeb, 0x75, 0x21, 0xfc, 0x6f, 0x17, 0xf7, 0xf0, 0x94, 0xfd, 0x6f, 0x03,
-	0xa0, 0x4b, 0xa5, 0xea, 0x46, 0xaf, 0x47, 0xdd, 0xaf, 0xac, 0xcd, 0xb3, 0x91, 0xed, 0x5e, 0x4f,
-	0xf5, 0x84, 0x51, 0xc5, 0xab, 0x27, 0x55, 0x1e, 0xdd, 0x87, 0x6b, 0x4c, 0x5c, 0x57, 0xb7, 0x4f,
-	0x3e, 0xc3, 0x1d, 0xcf, 0xd5, 0x3b, 0xf6, 0x90, 0x07, 0xe8, 0xbc, 0xb6, 0xcc, 0x89, 0x87, 0x8c,
-	0xf6, 0x80, 0x90, 0xd4, 0xcf, 0x73, 0xc2, 0x60, 0x64, 0xf3, 0x5f, 0xfa, 0x7b, 0x57, 0xa0, 0xd4,
-	0x19, 0x3a, 0xae, 0xed, 0x88, 0x83, 0x82, 0x3d, 0xa1, 0x06, 0x14, 0x7b, 0x66, 0xdf, 0x64, 0x2a,
-	0x14, 0x35, 0xf6, 0x80, 0xd6, 0x61, 0xbe, 0x6b, 0x3a, 0xb8, 0x43, 0xbc, 0x8e, 0x6e, 0xa2, 0xa2,
-	0x16, 0x0c, 0xa8, 0xcf, 0x00, 0x85, 0x35, 0xe2, 0x66, 0xd8, 0x82, 0xa2, 0xe9, 0xe1, 0xbe, 0xdb,
-	0xcc, 0x6d, 0xe6, 0xef, 0x54, 0xee, 0x37, 0xe3, 0x56, 0x10, 0xb1, 0x4b, 0x63, 0x6c, 0x64, 0x05,
-	0xfa, 0xb6, 0x83, 0xb9, 0x9d, 0xe9, 0xdf, 0xea, 0x1f, 0xe4, 0x60, 0x8d, 0x71, 0x1f, 0x63, 0x6f,
-	0xdb, 0xf3, 0x1c, 0xf3, 0x64, 0x48, 0x5e, 0x39, 0xed, 0x65, 0x0e, 0xed, 0x9d, 0xd9, 0xf8, 0xde,
-	0xb9, 0x01, 0xeb, 0x72, 0x15, 0xd8, 0x77, 0xaa, 0x7f, 0x98, 0x83, 0xe5, 0xed, 0x6e, 0xd7, 0xc1,
-	0xae, 0x8b, 0xbb, 0x87, 0xe4, 0x78, 0x7e, 0x44, 0x6d, 0x76, 0x47, 0x58, 0x92, 0x79, 0x01, 0xda,
-	0xe2, 0x47, 0x77, 0xc0, 0x22, 0xac, 0xfb, 0x00, 0x1a, 0xae, 0x67, 0x3b, 0xc6, 0x19, 0xd6, 0xc9,
-	0xd9, 0xaf, 0x1b, 0x4c, 0x1a, 0x8f, 0xc9, 0xf5, 0x2d, 0x0a, 0x08, 0x0e, 0xec, 0x2e, 0xe6, 0xaf,
-	0xd1, 0x10, 0x67, 0x0f, 0x8d, 0xa9, 0x7b, 0x80, 0x8e, 0x1c, 0x9b, 0x38, 0x4a, 0xdb, 0x3a, 0xb5,
-	0x2f, 0x6b, 0x20, 0xf5, 0x7d, 0x58, 0x8e, 0x88, 0xe1, 0x8b, 0xf9, 0x16, 0x54, 0x07, 0x6c, 0x58,
-	0x77, 0x8d, 0x9e, 0xc7, 0xed, 0x57, 0xe1, 0x63, 0xc7, 0x46, 0xcf, 0x53, 0xff, 0x73, 0x0e, 0x4a,
-	0xcc, 0x53, 0x89, 0x73, 0x85, 0x76, 0x40, 0xd5, 0xf7, 0xf7, 0xdb, 0xb0, 0xc8, 0xa3, 0x1c, 0xee,
-	0xea, 0x24, 0x5c, 0x73, 0x6b, 0x2f, 0xf8, 0xa3, 0x47, 0x86, 0x77, 0x8e, 0x9a, 0x30, 0xf7, 0x02,
-	0x3b, 0x6e, 0xe0, 0x6b, 0xe2, 0x91, 0x7c, 0x8e, 0xeb, 0x19, 0xde, 0xd0, 0xa5, 0x21, 0x79, 0x31,
-	0xfc, 0x39, 0xec, 0xd5, 0x5b, 0xc7, 0x94, 0xac, 0x71, 0x36, 0xf4, 0x1e, 0xcc, 0xbb, 0x9e, 0x83,
-	0x8d, 0x3e, 0x59, 0x5a, 0x12, 0x69, 0xab, 0x3b, 0x35, 0x72, 0x8e, 0xfd, 0xf4, 0x67, 0x37, 0xcb,
-	0xc7, 0x94, 0xd0, 0xde, 0xd5, 0xca, 0x8c, 0xa5, 0xdd, 0x8d, 0x9d, 0x89, 0xa5, 0xcb, 0xc1, 0x95,
-	0x6d, 0xf2, 0x4e, 0xf2, 0x76, 0x22, 0x63, 0x6e, 0x02, 0x19, 0x65, 0x36, 0x6d, 0x9b, 0x9e, 0xcd,
-	0xf8, 0xd5, 0xc0, 0x74, 0x30, 0x95, 0x51, 0x9e, 0x44, 0x0f, 0x3e, 0x6f, 0xdb, 0x43, 0xfb, 0xd0,
-	0x0c, 0xac, 0x4d, 0xec, 0xd4, 0x35, 0x3c, 0x43, 0xb7, 0x6c, 0xab, 0x83, 0x9b, 0xf3, 0xd4, 0x14,
-	0x0b, 0xdc, 0x14, 0xc5, 0x03, 0x32, 0xa8, 0xad, 0xf8, 0xec, 0x8f, 0x39, 0x37, 0x1d, 0x47, 0xef,
-	0x01, 0x4a, 0x0a, 0x6a, 0x02, 0x5d, 0xba, 0x7a, 0x62, 0x0e, 0xda, 0x87, 0x4d, 0xc9, 0x7b, 0x83,
-	0x21, 0x82, 0x4e, 0xeb, 0x74, 0xf2, 0x46, 0x62, 0xf2, 0x9e, 0x18, 0x20, 0xa0, 0xf5, 0x6b, 0x80,
-	0x4e, 0xcd, 0x57, 0xb8, 0x1b, 0x3d, 0xc3, 0x2b, 0x34, 0x36, 0xd6, 0x28, 0x25, 0x7c, 0x82, 0xb7,
-	0xa0, 0x9e, 0x3c, 0xb9, 0xab, 0xa3, 0x4f, 0xee, 0x9a, 0x13, 0x3f, 0xb2, 0x9f, 0xc2, 0x35, 0xf9,
-	0x51, 0xbd, 0x30, 0xe6, 0x51, 0xdd, 0xc0, 0x29, 0x67, 0xb4, 0x67, 0x7b, 0x46, 0x8f, 0x7d, 0xc6,
-	0x22, 0xfd, 0x8c, 0x79, 0x3a, 0x42, 0xf5, 0xbf, 0x09, 0x15, 0xd3, 0xea, 0x99, 0x16, 0x66, 0xf4,
-	0x25, 0x4a, 0x07, 0x36, 0x24, 0x18, 0x1c, 0xdc, 0xb7, 0x3d, 0xce, 0x50, 0x63, 0x0c, 0x6c, 0x88,
-	0x32, 0x90, 0x40, 0xd6, 0x33, 0x4c, 0x8b, 0xd1, 0x11, 0x7b, 0x01, 0x1d, 0x21, 0x64, 0xf5, 0xdb,
-	0x50, 0x62, 0xbb, 0x03, 0x55, 0x60, 0xae, 0x7d, 0xf0, 0x9d, 0xed, 0x47, 0xed, 0xdd, 0xda, 0x0c,
-	0x5a, 0x80, 0xf9, 0xa7, 0x47, 0x8f, 0x0e, 0xb7, 0x77, 0xdb, 0x07, 0xfb, 0xb5, 0x1c, 0x5a, 0x04,
-	0x78, 0x70, 0xf8, 0xf8, 0x71, 0xfb, 0xc9, 0x13, 0xf2, 0x3c, 0x4b, 0xc8, 0xfc, 0x79, 0x6f, 0xb7,
-	0x96, 0x47, 0x55, 0x28, 0xef, 0xee, 0x3d, 0xda, 0xa3, 0xc4, 0x82, 0xfa, 0x4f, 0x05, 0x40, 0x6c,
-	0xe3, 0xed, 0xe0, 0x33, 0xd3, 0xba, 0xca, 0x69, 0xf4, 0x7a, 0x02, 0x46, 0x74, 0x23, 0x15, 0x2e,
-	0xb7, 0x91, 0xa4, 0x9e, 0x35, 0x37, 0x55, 0xcf, 0x2a, 0x5f, 0xc9, 0xb3, 0xbe, 0xc8, 0x3b, 0xbd,
-	0x32, 0xc6, 0x4e, 0x57, 0xff, 0x71, 0x16, 0x96, 0x23, 0x7e, 0xc4, 0x8f, 0x9d, 0xd7, 0xe6, 0x17,
-	0x91, 0x73, 0xa1, 0x30, 0xf2, 0x5c, 0x90, 0x7a, 0x40, 0x71, 0xaa, 0x1e, 0x50, 0xba, 0x8a, 0x07,
-	0xa8, 0xff, 0xe3, 0x1b, 0xf0, 0x81, 0xdd, 0x27, 0xd0, 0xe2, 0xb2, 0x3b, 0x31, 0x62, 0x98, 0xdc,
-	0x48, 0xc3, 0xec, 0xc3, 0xa6, 0xfb, 0xdc, 0x1c, 0xe8, 0xf6, 0x0b, 0xec, 0x38, 0x66, 0x17, 0xeb,
-	0x12, 0xf7, 0x29, 0x52, 0x40, 0xb7, 0x41, 0xf8, 0x0e, 0x39, 0xdb, 0x9e, 0xc4, 0x95, 0xd2, 0x5d,
-	0x78, 0xf6, 0xea, 0x2e, 0x9c, 0xbf, 0x8a, 0x0b, 0x17, 0xc6, 0x71, 0xe1, 0x15, 0x68, 0x44, 0x17,
-	0x80, 0xc3, 0xc3, 0x7f, 0xc9, 0xc1, 0x4d, 0x46, 0x20, 0x80, 0xf7, 0x08, 0x5b, 0x5d, 0xd3, 0x3a,
-	0x63, 0x96, 0x74, 0x7f, 0x59, 0xf1, 0xf2, 0x0e, 0xd4, 0xfc, 0x45, 0xd6, 0x79, 0x1a, 0xc0, 0x2c,
-	0xb4, 0x28, 0x56, 0xf6, 0x41, 0x2c, 0x1d, 0x28, 0x84, 0xd2, 0x01, 0xf5, 0x14, 0x36, 0xd3, 0x3f,
-	0x69, 0x24, 0xfc, 0x0f, 0xa6, 0x8e, 0x82, 0xff, 0x3f, 0xce, 0xc1, 0x35, 0xc6, 0xbd, 0x6b, 0xbf,
-	0xb4, 0x7a, 0xb6, 0xd1, 0x9d, 0xba, 0xc5, 0xee, 0x41, 0x23, 0xb0, 0x18, 0x4b, 0xc2, 0xe8, 0x9a,
-	0x33, 0xbb, 0x05, 0xae, 0xc4, 0xd4, 0x20, 0xa8, 0x44, 0x6a, 0x12, 0x74, 0x1b, 0x8a, 0x8e, 0x61,
-	0x9d, 0x61, 0x5e, 0xe3, 0x5a, 0x0a, 0xe9, 0x43, 0x86, 0x35, 0x46, 0x55, 0xff, 0x36, 0x07, 0x45,
-	0x3a, 0x80, 0x3e, 0x80, 0x8a, 0xeb, 0x19, 0x8e, 0xa7, 0x87, 0x93, 0x84, 0xeb, 0xb1, 0x69, 0xc7,
-	0x84, 0x83, 0xe6, 0x0a, 0xad, 0x19, 0x0d, 0x5c, 0xff, 0x09, 0x7d, 0x0d, 0x8a, 0xf4, 0x89, 0xe7,
-	0x08, 0x0d, 0xd9, 0xbc, 0xd6, 0x8c, 0xc6, 0x98, 0x28, 0x6c, 0x1e, 0x9e, 0x9e, 0x9a, 0xaf, 0xb8,
-	0x76, 0xd7, 0xe2, 0xec, 0x94, 0xd8, 0x9a, 0xd1, 0x38, 0xdb, 0xce, 0x1c, 0xd7, 0x52, 0x3d, 0x86,
-	0xa5, 0x98, 0x22, 0x04, 0x86, 0x70, 0x94, 0x41, 0x15, 0xc8, 0x31, 0x18, 0xc2, 0x60, 0x06, 0x7d,
-	0x9b, 0xcf, 0x10, 0x24, 0x92, 0x82, 0x81, 0x4a, 0x50, 0xdf, 0x03, 0x08, 0x84, 0x8e, 0x94, 0xa7,
-	0xde, 0x83, 0x4a, 0x48, 0x4b, 0x9a, 0x8a, 0x30, 0x7e, 0xf6, 0x49, 0x6c, 0x02, 0x93, 0xc1, 0x58,
-	0xd4, 0x9f, 0xe4, 0x60, 0x25, 0xee, 0x37, 0x41, 0x72, 0xce, 0x56, 0x39, 0x99, 0x9c, 0xb3, 0x19,
-	0x1a, 0xa7, 0xa3, 0x6f, 0x41, 0x55, 0xe0, 0xce, 0x9e, 0xe9, 0x0a, 0x4b, 0x6f, 0x04, 0xfc, 0x1c,
-	0x7c, 0x86, 0x93, 0x5e, 0xad, 0xe2, 0x06, 0x83, 0xe8, 0x11, 0xd4, 0x84, 0x84, 0x2e, 0xd7, 0xa3,
-	0x99, 0xa7, 0xbb, 0xe1, 0xad, 0x84, 0x94, 0xb8, 0xa2, 0xda, 0x92, 0x1b, 0x25, 0xa8, 0x3f, 0xcf,
-	0x41, 0x8d, 0xa9, 0x78, 0x95, 0x12, 0xcc, 0x6b, 0x3b, 0x51, 0xb7, 0x61, 0x23, 0x71, 0x44, 0xea,
-	0x03, 0xec, 0x08, 0xf0, 0x4e, 0xb7, 0x4b, 0x59, 0x53, 0xe2, 0x27, 0xe2, 0x11, 0x76, 0xb8, 0x09,
-	0xd4, 0x0f, 0xa1, 0x1e, 0xfa, 0xc0, 0x49, 0x17, 0x4c, 0xfd, 0x41, 0x5e, 0xcc, 0xbf, 0x6a, 0x65,
-	0x44, 0x6a, 0xa1, 0x77, 0xa1, 0x16, 0xb2, 0x90, 0x83, 0x89, 0xef, 0x31, 0x1b, 0x2d, 0x05, 0x36,
-	0xa2, 0xc3, 0x51, 0xd6, 0x48, 0x7c, 0x0d, 0x58, 0x79, 0x80, 0x5d, 0x87, 0x79, 0x07, 0x13, 0x16,
-	0xf3, 0x05, 0xe6, 0x26, 0x0a, 0x06, 0x82, 0x58, 0x53, 0x0c, 0xc7, 0x9a, 0x20, 0x0b, 0x9e, 0x1b,
-	0x2f, 0x0b, 0x6e, 0xc3, 0x12, 0x0f, 0x6d, 0xa6, 0xd5, 0xe9, 0x0d, 0xbb, 0x38, 0x80, 0x1b, 0x29,
-	0x51, 0xb9, 0xcd, 0xf9, 0xb4, 0x45, 0x36, 0x51, 0x3c, 0xa3, 0x2d, 0x58, 0x1e, 0xba, 0x58, 0x8f,
-	0x8b, 0x2b, 0x53, 0xcd, 0xeb, 0x43, 0x17, 0x1f, 0x46, 0xf8, 0xd5, 0x67, 0x22, 0x41, 0x18, 0xb3,
-	0x36, 0x34, 0xfe, 0xe1, 0xf0, 0xd3, 0x02, 0x2c, 0x46, 0xb9, 0x25, 0x4e, 0x9c, 0x1b, 0xe1, 0xc4,
-	0xb3, 0x69, 0xf5, 0x85, 0xfc, 0x78, 0x96, 0x8d, 0x16, 0x0c, 0x0a, 0x53, 0x28, 0x18, 0x14, 0xa7,
-	0x50, 0x30, 0x28, 0x4d, 0xbf, 0x60, 0x30, 0x37, 0x09, 0x06, 0x9b, 0x56, 0x5e, 0x90, 0x02, 0xe6,
-	0xca, 0x69, 0x60, 0x2e, 0x9a, 0x00, 0x43, 0x2c, 0x01, 0x46, 0xef, 0x86, 0xb1, 0x2d, 0xcb, 0x8b,
-	0xaa, 0x72, 0x5c, 0xab, 0xf6, 0xc4, 0x01, 0x12, 0xdf, 0x10, 0x48, 0x81, 0xb2, 0xaf, 0x48, 0x8e,
-	0xba, 0xa3, 0xff, 0x8c, 0xbe, 0x01, 0xab, 0xf8, 0x15, 0xe5, 0xd3, 0xdd, 0x0b, 0xd7, 0xc3, 0xfd,
-	0x40, 0x67, 0xe6, 0xb9, 0xd7, 0x38, 0xf9, 0x98, 0x52, 0x85, 0xde, 0xea, 0x2f, 0x72, 0xd0, 0x0c,
-	0xa5, 0x3f, 0x57, 0x2c, 0x65, 0xbf, 0xb6, 0x10, 0xbf, 0x12, 0xa9, 0xbe, 0x15, 0x47, 0x15, 0xd9,
-	0x72, 0x29, 0xb6, 0xf5, 0xe0, 0xba, 0xe4, 0x63, 0x79, 0x64, 0x98, 0x30, 0xff, 0x08, 0x4e, 0x87,
-	0xd9, 0x11, 0xa7, 0xc3, 0xef, 0x8b, 0xb7, 0x7e, 0x6c, 0x5a, 0xa6, 0x7b, 0x7e, 0x45, 0x1b, 0x4f,
-	0xa6, 0xa6, 0xba, 0x0e, 0x8a, 0xec, 0xe5, 0x3c, 0x45, 0xf8, 0x8b, 0x9c, 0x48, 0xde, 0xf6, 0xb1,
-	0xd7, 0x3e, 0x72, 0xbf, 0x70, 0x2b, 0xaf, 0xfe, 0x65, 0x4e, 0x64, 0x37, 0x42, 0x43, 0xbe, 0x5c,
-	0x35, 0xc8, 0x9b, 0x03, 0x16, 0xc6, 0xab, 0x1a, 0xf9, 0x13, 0xdd, 0x82, 0x05, 0x01, 0x7a, 0xc2,
-	0xbd, 0x0c, 0x81, 0xa5, 0x68, 0x13, 0x83, 0x62, 0x3e, 0x13, 0x77, 0x30, 0x67, 0xc9, 0x73, 0xcc,
-	0x47, 0x86, 0x18, 0xc3, 0x3d, 0x68, 0x38, 0xb8, 0x67, 0x1a, 0x27, 0x3d, 0xac, 0x87, 0x39, 0x79,
-	0xcb, 0x57, 0xd0, 0x8e, 0xfc, 0x19, 0xea, 0x5f, 0xe5, 0x61, 0x8d, 0xa9, 0xf8, 0x74, 0xd0, 0x35,
-	0x3c, 0x2c, 0x36, 0xd7, 0x17, 0x20, 0x63, 0x18, 0xb3, 0x0c, 0x31, 0x37, 0x46, 0xb6, 0x9d, 0x1e,
-	0xa0, 0x0b, 0x57, 0x4f, 0x92, 0x8b, 0x57, 0x49, 0x92, 0x4b, 0xe3, 0x24, 0xc9, 0x37, 0x60, 0x5d,
-	0xbe, 0x46, 0x7c, 0x27, 0x3c, 0x83, 0xca, 0xb1, 0xe1, 0x89, 0x2f, 0x47, 0x6d, 0x58, 0xa0, 0xa7,
-	0xa4, 0x69, 0x5b, 0x3a, 0xe1, 0x9f, 0xe8, 0x70, 0xac, 0x8a, 0xa9, 0xbb, 0x86, 0x87, 0xd5, 0x7f,
-	0x9f, 0x85, 0x39, 0x8e, 0x33, 0x27, 0x8d, 0x31, 0xbf, 0x06, 0xe5, 0x81, 0xed, 0x9a, 0x9e, 0xc0,
-	0x0b, 0x91, 0x34, 0x8d, 0xcb, 0x3c, 0xe2, 0x0c, 0x9a, 0xcf, 0x8a, 0x3e, 0x84, 0xe5, 0x88, 0x85,
-	0xf8, 0x3a, 0xe5, 0x65, 0xeb, 0x14, 0xd8, 0xfc, 0x21, 0xbe, 0x60, 0x4b, 0x74, 0x0b, 0x16, 0x64,
-	0x55, 0x88, 0x6a, 0x98, 0x93, 0xa0, 0x31, 0x72, 0xd4, 0x85, 0x96, 0xc2, 0x5f, 0xc8, 0xbc, 0x56,
-	0x27, 0x24, 0xdf, 0xfc, 0xbb, 0x64, 0x21, 0xef, 0xfb, 0xd5, 0x27, 0xdc, 0xd5, 0x79, 0xb5, 0x99,
-	0xce, 0x60, 0xab, 0x17, 0x28, 0xdc, 0xa6, 0x34, 0x3a, 0xe7, 0x2b, 0x50, 0xa2, 0x3b, 0x90, 0xa0,
-	0xcd, 0x7c, 0x34, 0xb5, 0xa5, 0xdb, 0x4f, 0xe3, 0x64, 0xb5, 0x05, 0x45, 0x3a, 0x80, 0xd6, 0x60,
-	0x9e, 0xed, 0x59, 0x6b, 0xd8, 0xa7, 0xf6, 0x2d, 0x6a, 0x65, 0x3a, 0x70, 0x30, 0xec, 0x23, 0x15,
-	0x0a, 0x96, 0xdd, 0x15, 0x45, 0x9d, 0x45, 0x6e, 0x87, 0xd2, 0x81, 0xdd, 0xc5, 0xed, 0x5d, 0x8d,
-	0xd2, 0xd4, 0x16, 0x2c, 0xc5, 0xec, 0x4a, 0x23, 0x06, 0xc9, 0x96, 0xad, 0x61, 0xff, 0x04, 0x3b,
-	0x5c, 0x2a, 0xed, 0xdb, 0x1d, 0xd0, 0x11, 0x02, 0x95, 0x4d, 0xab, 0x8b, 0x5f, 0x89, 0xc6, 0x25,
-	0x7d, 0x50, 0xff, 0x35, 0x07, 0xcb, 0x5c, 0xd4, 0xd5, 0x2a, 0xd4, 0x6f, 0xc6, 0x67, 0xde, 0x81,
-	0xa5, 0xbe, 0xf1, 0x4a, 0xa7, 0x9d, 0x42, 0x9e, 0x3e, 0xb3, 0xd8, 0xb8, 0xd0, 0x37, 0x5e, 0x05,
-	0x8d, 0x43, 0xf5, 0xcf, 0x66, 0xa1, 0x11, 0xfd, 0x2c, 0x1e, 0x8f, 0xef, 0x01, 0x88, 0xe8, 0xeb,
-	0xeb, 0x59, 0xe7, 0x7a, 0xce, 0xf3, 0x19, 0xed, 0x5d, 0x6d, 0x9e, 0x33, 0xd1, 0xd2, 0x66, 0xcd,
-	0x10, 0xdd, 0x4b, 0xf6, 0x4a, 0xb7, 0x39, 0x4b, 0x17, 0x3a, 0x94, 0xea, 0x4a, 0xfa, 0x9b, 0xda,
-	0x92, 0x3f, 0x8d, 0x3e, 0xbb, 0xf4, 0xba, 0x86, 0x63, 0xbe, 0x30, 0x3c, 0x4c, 0xfd, 0x95, 0x39,
-	0xfa, 0x2a, 0x7f, 0xf9, 0x12, 0x75, 0x8d, 0x23, 0x46, 0x7f, 0x88, 0x2f, 0x34, 0x18, 0xf8, 0x7f,
-	0xcb, 0xcb, 0xab, 0x85, 0x4b, 0x94, 0x57, 0xd5, 0x3f, 0xcf, 0xfb, 0x86, 0xb9, 0x62, 0x21, 0x74,
-	0x72, 0x4b, 0xa6, 0x6c, 0xf8, 0xd9, 0xcb, 0x6e, 0xf8, 0xfc, 0xf8, 0x1b, 0xbe, 0x90, 0xb6, 0xe1,
-	0xa3, 0x88, 0xb8, 0x14, 0x47, 0xc4, 0xef, 0x40, 0x90, 0x90, 0xea, 0x58, 0xf7, 0x8c, 0x33, 0x7e,
-	0xdb, 0x28, 0x50, 0x65, 0xef, 0x89, 0x71, 0x86, 0xf6, 0x61, 0x61, 0x38, 0xe8, 0xd9, 0x46, 0x57,
-	0x77, 0xb0, 0x3b, 0xec, 0x91, 0x2c, 0x85, 0x78, 0x88, 0x9a, 0xf4, 0x69, 0xb2, 0xca, 0x4f, 0x07,
-	0xbc, 0x92, 0x31, 0xec, 0x79, 0x5a, 0x75, 0x18, 0x7a, 0x52, 0xff, 0x28, 0x07, 0xcd, 0x34, 0xd6,
-	0xec, 0xb8, 0xf1, 0x15, 0x98, 0xa3, 0xcd, 0x71, 0xd1, 0xa2, 0x4f, 0x84, 0x8e, 0x12, 0x21, 0xb7,
-	0xbb, 0xe8, 0x36, 0x14, 0xce, 0x0d, 0xf7, 0x9c, 0x97, 0xba, 0xea, 0xa2, 0xed, 0x4e, 0x5f, 0xd7,
-	0x32, 0xdc, 0x73, 0x8d, 0x92, 0xd5, 0x5d, 0xb8, 0x16, 0x73, 0x14, 0xbe, 0x85, 0xbe, 0x0a, 0x75,
-	0x77, 0xd8, 0xe9, 0x60, 0xd7, 0x3d, 0x1d, 0xf6, 0x74, 0x1e, 0xfa, 0x98, 0x36, 0xb5, 0x80, 0x70,
-	0xc4, 0x62, 0xde, 0xe7, 0x79, 0xff, 0x7b, 0x1e, 0x1b, 0xcf, 0x31, 0x0b, 0x9b, 0x5f, 0xf0, 0x20,
-	0xf3, 0x26, 0x0e, 0xa6, 0xd4, 0x83, 0xa6, 0x98, 0x7e, 0xd0, 0x4c, 0xc7, 0x57, 0xd5, 0x35, 0xb8,
-	0x2e, 0x59, 0x11, 0x0e, 0x30, 0x7e, 0x94, 0xf3, 0xa9, 0xd3, 0x48, 0xb5, 0xde, 0xc8, 0x82, 0xa9,
-	0x3f, 0xc9, 0x81, 0x22, 0x53, 0xfa, 0xcb, 0x1c, 0xf3, 0xd5, 0x7f, 0x08, 0x3e, 0x6a, 0x2a, 0x19,
-	0xd9, 0xe4, 0x56, 0xf8, 0x00, 0xe6, 0x58, 0x34, 0x13, 0x1f, 0x9f, 0x12, 0xce, 0x7c, 0x73, 0x93,
-	0x70, 0x26, 0xa6, 0x24, 0x22, 0x59, 0x98, 0xeb, 0xcd, 0x46, 0xb2, 0x0d, 0x58, 0x93, 0x1a, 0x92,
-	0xbb, 0xfc, 0x7f, 0xe5, 0x00, 0x45, 0x4a, 0xd5, 0x6f, 0xc6, 0xd7, 0x77, 0x60, 0x89, 0x55, 0x3e,
-	0xf5, 0xf1, 0x5d, 0x7e, 0x91, 0xcd, 0xf0, 0x41, 0x9f, 0x5f, 0xfe, 0xcc, 0x4b, 0x5b, 0x2d, 0x85,
-	0xcc, 0x56, 0xcb, 0x0f, 0x03, 0xe8, 0x17, 0xa9, 0x3d, 0xde, 0x8d, 0xd6, 0x1e, 0xaf, 0x4b, 0x0b,
-	0xfa, 0x23, 0x8a, 0x8f, 0xe9, 0x6d, 0xdc, 0xfc, 0x95, 0xda, 0xb8, 0xff, 0x36, 0xeb, 0x23, 0x5f,
-	0xbf, 0xa8, 0x19, 0x0e, 0x1a, 0xb9, 0xf1, 0xa3, 0x7c, 0x34, 0x9a, 0xce, 0xc6, 0xa3, 0xa9, 0xdf,
-	0x45, 0xb1, 0x4f, 0x4f, 0x5d, 0x2c, 0x12, 0x6b, 0xd6, 0x45, 0x39, 0xa4, 0x43, 0xd3, 0xb9, 0xbb,
-	0x2d, 0x89, 0xda, 0x45, 0x19, 0xc2, 0x48, 0x39, 0x94, 0x4a, 0x97, 0x3d, 0x94, 0xe6, 0x92, 0x87,
-	0x92, 0xfa, 0xf7, 0x39, 0x58, 0x49, 0xb4, 0x5b, 0xbe, 0x34, 0xbb, 0x41, 0xfd, 0xef, 0x02, 0xac,
-	0xa6, 0x74, 0x8b, 0xbe, 0xa4, 0xb8, 0x3f, 0x15, 0x25, 0x14, 0xd2, 0x51, 0x42, 0xdc, 0x71, 0x2b,
-	0x49, 0xc7, 0x8d, 0xba, 0x7e, 0x55, 0xe2, 0xfa, 0x91, 0x0b, 0x65, 0x2c, 0x5b, 0x16, 0x9d, 0x3b,
-	0xca, 0xf2, 0x06, 0xbc, 0x51, 0x9e, 0xf4, 0xcc, 0x5f, 0xe6, 0x4e, 0xc9, 0x7b, 0x50, 0xb0, 0xf0,
-	0x2b, 0x71, 0x4f, 0x30, 0xc3, 0xa3, 0x28, 0x5b, 0x24, 0xa0, 0xc0, 0xf8, 0x28, 0xe4, 0x4f, 0x73,
-	0x50, 0x3f, 0x32, 0x1c, 0xef, 0xcd, 0x42, 0xa6, 0x58, 0xde, 0x3f, 0x1b, 0xcf, 0xfb, 0xd5, 0x06,
-	0xa0, 0xb0, 0x56, 0xfc, 0xd0, 0x7b, 0x09, 0xd5, 0x1d, 0xc3, 0xeb, 0x9c, 0x5f, 0x5a, 0xcd, 0x6f,
-	0x40, 0xd9, 0x61, 0x04, 0x71, 0x50, 0x28, 0xa1, 0x0b, 0xcc, 0x21, 0xd1, 0xf4, 0xa4, 0xf0, 0x79,
-	0xd5, 0x1f, 0xd5, 0xa0, 0x16, 0x27, 0xa3, 0x5d, 0x58, 0x60, 0xc5, 0x43, 0x9d, 0x05, 0x46, 0x1e,
-	0xc7, 0x37, 0xe2, 0x57, 0xa2, 0x23, 0xff, 0x43, 0xd1, 0x9a, 0xd1, 0xaa, 0x27, 0xa1, 0x61, 0xf4,
-	0x4d, 0x00, 0x2e, 0xe5, 0x0c, 0x07, 0xff, 0xb0, 0x11, 0x13, 0x11, 0xf4, 0x86, 0x5b, 0x33, 0xda,
-	0xfc, 0x89, 0x18, 0x0b, 0xa9, 0xc0, 0x2e, 0x95, 0xf3, 0x78, 0x9e, 0x50, 0x21, 0xb2, 0xba, 0x81,
-	0x0a, 0x6c, 0x18, 0xfd, 0x26, 0x54, 0xb8, 0x14, 0xda, 0x12, 0x17, 0x29, 0xba, 0xe4, 0x66, 0x77,
-	0x20, 0x81, 0x2b, 0x4d, 0x3b, 0xe2, 0xdb, 0x50, 0xe5, 0x15, 0xd3, 0x13, 0x02, 0x64, 0x79, 0xa3,
-	0x6a, 0x3d, 0x5e, 0xb4, 0x0f, 0x97, 0x6a, 0x5a, 0x33, 0x5a, 0xc5, 0x0e, 0x46, 0xc9, 0x87, 0x70,
-	0x11, 0x1d, 0x9a, 0xb7, 0xf1, 0x8b, 0x78, 0x1b, 0x71, 0x19, 0x91, 0xf4, 0x9f, 0x7c, 0x88, 0x1d,
-	0x1a, 0x26, 0xb6, 0xe4, 0x52, 0x88, 0x2d, 0xcb, 0x71, 0x5b, 0xc6, 0xfb, 0xec, 0xc4, 0x96, 0xb6,
-	0x18, 0x23, 0x56, 0xe0, 0x93, 0xa9, 0x15, 0xe6, 0xe3, 0x56, 0x48, 0x34, 0xa1, 0x89, 0x15, 0x6c,
-	0x7f, 0x10, 0x3d, 0x81, 0xe5, 0xb0, 0x15, 0xc4, 0x8a, 0xb0, 0xbd, 0xa8, 0x4a, 0x8d, 0x11, 0x5f,
-	0x96, 0xba, 0x1d, 0xa7, 0xa1, 0x4f, 0xa1, 0xc1, 0xa5, 0x9e, 0x52, 0x18, 0x28, 0xc4, 0x56, 0xa8,
-	0xd8, 0x5b, 0x71, 0xb1, 0x12, 0xd0, 0xdd, 0x9a, 0xd1, 0x90, 0x9d, 0x20, 0xa2, 0x3d, 0x58, 0x0c,
-	0x6c, 0xa5, 0x9b, 0x03, 0xb7, 0xd9, 0x90, 0x9b, 0x3c, 0xd2, 0xbd, 0x08, 0x4c, 0x4e, 0x86, 0x07,
-	0x2e, 0xfa, 0x0c, 0xd6, 0x42, 0x56, 0xd3, 0x07, 0xec, 0xda, 0x90, 0xce, 0x76, 0xba, 0xdb, 0x5c,
-	0xa1, 0x32, 0xdf, 0x95, 0x59, 0x51, 0x7a, 0x69, 0xaa, 0x35, 0xa3, 0x35, 0xed, 0x14, 0x16, 0xf4,
-	0x89, 0xdf, 0xf0, 0xf6, 0x2f, 0x5e, 0xac, 0x52, 0xf9, 0x37, 0xe3, 0xf2, 0x63, 0x40, 0xa0, 0x35,
-	0x23, 0x3a, 0xde, 0x82, 0x80, 0x7e, 0x17, 0x56, 0xb8, 0xac, 0x21, 0x2d, 0x5a, 0x07, 0xf5, 0xf2,
-	0x26, 0x15, 0x79, 0x3b, 0x2e, 0x52, 0xda, 0x7f, 0x68, 0xcd, 0x68, 0x7c, 0x79, 0xa2, 0x64, 0x74,
-	0x00, 0xf5, 0x88, 0x33, 0xf4, 0xed, 0x17, 0xb8, 0xa9, 0xc8, 0xbb, 0xf3, 0x74, 0xb9, 0x1f, 0xdb,
-	0x2f, 0x42, 0x0b, 0xb6, 0x64, 0x47, 0x29, 0xe8, 0xdb, 0x80, 0xa2, 0x6e, 0x40, 0x05, 0xae, 0x51,
-	0x81, 0x6f, 0xc9, 0x9d, 0x20, 0x2a, 0xb1, 0x66, 0xc7, 0x48, 0x09, 0x15, 0x3b, 0xf6, 0xe0, 0xa2,
-	0xb9, 0x9e, 0xa1, 0xe2, 0x03, 0x7b, 0x70, 0x21, 0x57, 0x91, 0x50, 0x92, 0x2a, 0x52, 0x81, 0x1b,
-	0x59, 0x2a, 0x46, 0x25, 0x46, 0x54, 0xa4, 0x22, 0x77, 0x83, 0xae, 0x13, 0x8b, 0x2c, 0xd5, 0x94,
-	0xdb, 0x3a, 0xb1, 0xd0, 0x22, 0x90, 0x00, 0x8b, 0x2d, 0xfb, 0xb0, 0x18, 0xf4, 0xae, 0x68, 0x70,
-	0x61, 0x37, 0xbe, 0x6f, 0x24, 0xc4, 0xc4, 0xa3, 0xcb, 0x82, 0x1b, 0x1e, 0x27, 0x3b, 0x5c, 0x08,
-	0xea, 0x1b, 0xcf, 0x31, 0xc7, 0x36, 0xf4, 0xce, 0xb7, 0x2c, 0xcd, 0x4c, 0x94, 0x8e, 0xc8, 0x0e,
-	0x77, 0xe3, 0x34, 0xb2, 0xc3, 0x23, 0x1f, 0x29, 0x76, 0xf8, 0x52, 0x7c, 0x87, 0xa7, 0x56, 0x38,
-	0xc8, 0x0e, 0x77, 0x13, 0x44, 0xf4, 0x5d, 0xb8, 0x26, 0x04, 0x47, 0x63, 0x47, 0x8d, 0x4a, 0x7e,
-	0x3b, 0x21, 0x59, 0x1e, 0x3c, 0xc4, 0x37, 0x47, 0xa2, 0xc7, 0x76, 0xec, 0x1a, 0x55, 0x3d, 0x1e,
-	0xf2, 0x93, 0xb9, 0x29, 0x09, 0xf9, 0xe1, 0x7b, 0x54, 0x8f, 0x25, 0xf7, 0xa8, 0x50, 0xdc, 0xfd,
-	0xe4, 0xc0, 0x9e, 0xb8, 0x5f, 0xec, 0x22, 0x15, 0x09, 0xdf, 0x14, 0x52, 0xf0, 0x6f, 0xbc, 0x1e,
-	0x0f, 0xdf, 0x09, 0x90, 0x43, 0xc2, 0xf7, 0xc0, 0x1f, 0x24, 0xf1, 0xd0, 0xc1, 0x2f, 0xec, 0xe7,
-	0x58, 0x17, 0xff, 0x6b, 0xbb, 0x1c, 0x77, 0x36, 0x8d, 0xd2, 0xb7, 0x8f, 0xda, 0x04, 0xf1, 0x06,
-	0xce, 0xc6, 0xa6, 0x6d, 0xd3, 0x7f, 0xc9, 0xdd, 0x99, 0x87, 0x39, 0x4e, 0x52, 0x3f, 0x81, 0x05,
-	0x8e, 0x19, 0x38, 0x9c, 0xff, 0x0d, 0x98, 0x77, 0xf8, 0xdf, 0x02, 0x7e, 0xac, 0x25, 0xe0, 0x07,
-	0xa3, 0x53, 0xfc, 0x11, 0x70, 0xab, 0x3f, 0xae, 0x41, 0x3d, 0xc1, 0x80, 0xf6, 0xe4, 0x08, 0xe4,
-	0x46, 0x1a, 0x02, 0x61, 0x53, 0x13, 0x10, 0xe4, 0x03, 0x09, 0x04, 0x59, 0x93, 0x42, 0x10, 0x5f,
-	0x40, 0x08, 0x83, 0xec, 0xc9, 0x31, 0xc8, 0x8d, 0x34, 0x0c, 0x12, 0x57, 0x82, 0xdb, 0xff, 0x23,
-	0x19, 0x08, 0x59, 0x97, 0x83, 0x10, 0x5f, 0x44, 0x18, 0x85, 0xec, 0x48, 0x51, 0xc8, 0x46, 0x0a,
-	0x0a, 0xf1, 0x45, 0x44, 0x60, 0xc8, 0x9e, 0x1c, 0x86, 0xdc, 0x48, 0x83, 0x21, 0xc1, 0xb7, 0x44,
-	0x70, 0xc8, 0x07, 0x12, 0x1c, 0xb2, 0x26, 0xc5, 0x21, 0x81, 0x41, 0x03, 0x20, 0xf2, 0x91, 0x0c,
-	0x88, 0xac, 0xcb, 0x81, 0x48, 0x60, 0x89, 0x10, 0x12, 0x79, 0x9a, 0x85, 0x44, 0x6e, 0x65, 0x22,
-	0x11, 0x5f, 0x9e, 0x04, 0x8a, 0x3c, 0xcb, 0x84, 0x22, 0x6f, 0x67, 0x43, 0x11, 0x5f, 0xb0, 0x0c,
-	0x8b, 0x7c, 0x9c, 0x82, 0x45, 0x6e, 0xa4, 0x61, 0x91, 0xb8, 0xdd, 0x39, 0x18, 0x79, 0x3e, 0x0e,
-	0x18, 0xf9, 0x95, 0x71, 0xc0, 0x88, 0xff, 0x82, 0x74, 0x34, 0xf2, 0x30, 0x0d, 0x8d, 0x6c, 0xa6,
-	0xa3, 0x11, 0x5f, 0x6c, 0x1c, 0x8e, 0xfc, 0xde, 0x08, 0x38, 0xf2, 0xce, 0x28, 0x38, 0xe2, 0x4b,
-	0x96, 0xe3, 0x91, 0xc3, 0x74, 0x3c, 0xf2, 0x56, 0x06, 0x1e, 0xf1, 0xa5, 0x26, 0x00, 0x89, 0x96,
-	0x01, 0x48, 0xd4, 0x2c, 0x40, 0xe2, 0x8b, 0x4c, 0x22, 0x92, 0xc3, 0x74, 0x44, 0xf2, 0x56, 0x06,
-	0x22, 0x91, 0x2a, 0x49, 0xf1, 0x83, 0x96, 0x01, 0x49, 0xd4, 0x2c, 0x48, 0x22, 0x57, 0x92, 0xca,
-	0xdc, 0x93, 0x63, 0x92, 0x1b, 0x69, 0x98, 0x24, 0x70, 0xd5, 0x08, 0x28, 0x69, 0xa5, 0x80, 0x92,
-	0x9b, 0xa9, 0xa0, 0xc4, 0x17, 0x14, 0x43, 0x25, 0x4f, 0xb3, 0x50, 0xc9, 0xad, 0x4c, 0x54, 0x12,
-	0xec, 0xf6, 0x24, 0x2c, 0x79, 0x96, 0x09, 0x4b, 0xde, 0xce, 0x86, 0x25, 0xc1, 0x6e, 0x97, 0xe0,
-	0x92, 0xdf, 0xce, 0xc6, 0x25, 0xb7, 0x47, 0xe0, 0x12, 0x5f, 0xb6, 0x14, 0x98, 0xec, 0x48, 0x81,
-	0x49, 0xf6, 0xfd, 0xee, 0x38, 0x32, 0x39, 0x48, 0x45, 0x26, 0xa3, 0x6f, 0x78, 0xcb, 0xa0, 0xc9,
-	0x47, 0x32, 0x68, 0xb2, 0x2e, 0x87, 0x26, 0x41, 0x40, 0x0f, 0x61, 0x93, 0x8f, 0x53, 0xb0, 0xc9,
-	0x8d, 0x34, 0x6c, 0x12, 0x38, 0x5d, 0x04, 0x9c, 0x00, 0x94, 0xfd, 0x5a, 0x8a, 0x0e, 0xcb, 0x12,
-	0x3c, 0x33, 0x79, 0x49, 0x25, 0xed, 0xc7, 0x49, 0xd4, 0x15, 0x68, 0xc8, 0x94, 0x52, 0x7f, 0xe1,
-	0x5f, 0xe3, 0x8f, 0x27, 0x3e, 0xbf, 0xcc, 0xdb, 0x5c, 0x1b, 0x00, 0x16, 0x7e, 0xa9, 0x73, 0x69,
-	0xfc, 0x67, 0x35, 0x2c, 0xfc, 0x92, 0xff, 0x7e, 0xca, 0xaf, 0x43, 0x93, 0x90, 0xa5, 0x42, 0x59,
-	0x59, 0xf3, 0x9a, 0x85, 0x5f, 0xee, 0x25, 0xe4, 0xaa, 0xff, 0x31, 0x0b, 0xab, 0x29, 0x61, 0x75,
-	0xd2, 0xa2, 0xd9, 0x01, 0xac, 0x4b, 0xee, 0x6b, 0x8d, 0xb8, 0x92, 0x70, 0x3d, 0x71, 0x75, 0xcb,
-	0xaf, 0x67, 0x7e, 0x1d, 0x56, 0xe4, 0xf2, 0xf8, 0xe7, 0x37, 0x64, 0x53, 0xc3, 0xc8, 0xff, 0x39,
-	0xbe, 0x70, 0x9b, 0x05, 0x8a, 0x63, 0x43, 0x9e, 0x18, 0xbe, 0x1a, 0xb6, 0x6d, 0x75, 0x99, 0x1a,
-	0x62, 0x7f, 0x3d, 0xc4, 0x17, 0x6e, 0x7a, 0x9b, 0xa5, 0x78, 0xa5, 0x36, 0xcb, 0xdf, 0xe5, 0x85,
-	0xa9, 0x13, 0x09, 0xf0, 0x6b, 0x2f, 0x68, 0x46, 0xdd, 0xa7, 0x34, 0x89, 0xfb, 0xcc, 0x66, 0xb8,
-	0x0f, 0x7a, 0x0a, 0x9b, 0xd1, 0x89, 0x92, 0x75, 0x97, 0xb6, 0xf8, 0xd7, 0xc3, 0xf2, 0x12, 0x4b,
-	0xff, 0x4d, 0x50, 0xd2, 0xc5, 0x72, 0x87, 0x5e, 0x4d, 0x91, 0x80, 0x5a, 0x50, 0x23, 0x93, 0x23,
-	0x5e, 0x50, 0x1c, 0xcb, 0x0b, 0x16, 0x2d, 0xfc, 0xf2, 0x38, 0x70, 0x04, 0x55, 0x11, 0x17, 0xa4,
-	0x93, 0x00, 0x21, 0x1e, 0x26, 0x42, 0xa5, 0x82, 0xff, 0x03, 0x61, 0x22, 0x8c, 0x42, 0xfe, 0x3f,
-	0x4c, 0x4c, 0x37, 0x4c, 0xfc, 0xa0, 0x10, 0x0d, 0x13, 0x57, 0xf2, 0xac, 0x2b, 0x85, 0x89, 0xd9,
-	0x49, 0xdc, 0x27, 0x9f, 0x15, 0x26, 0xbe, 0x0a, 0x75, 0xff, 0x7f, 0x75, 0x23, 0xff, 0x50, 0x51,
-	0xd6, 0x6a, 0x82, 0xe0, 0xe7, 0x02, 0x5f, 0x87, 0x15, 0xf9, 0xe6, 0xe7, 0x0d, 0xad, 0x86, 0x6c,
-	0xe3, 0x8f, 0x15, 0x89, 0x0a, 0xd3, 0x8e, 0x44, 0xc5, 0xc9, 0x23, 0x51, 0xe9, 0x52, 0x91, 0x68,
-	0x37, 0x1a, 0x89, 0x22, 0xfb, 0x6f, 0xfc, 0xff, 0x55, 0xfb, 0x61, 0x0e, 0x1a, 0xb2, 0xd7, 0x5d,
-	0xb6, 0xdb, 0xff, 0x06, 0xee, 0x1e, 0xde, 0xff, 0x93, 0x65, 0x28, 0x3f, 0xe6, 0xaa, 0xa0, 0xc7,
-	0x50, 0x65, 0x35, 0x21, 0xee, 0x90, 0xd9, 0xbd, 0x2c, 0x65, 0x44, 0xa1, 0x09, 0xed, 0xc2, 0xfc,
-	0x3e, 0xf6, 0xb8, 0xac, 0x8c, 0xa6, 0x96, 0x92, 0x55, 0x6d, 0x22, 0x4a, 0x31, 0x1c, 0x9c, 0xa6,
-	0x54, 0xa4, 0xac, 0xa7, 0x8c, 0x28, 0x3c, 0xa1, 0x16, 0x54, 0x08, 0xca, 0x67, 0x34, 0x17, 0x65,
-	0xf5, 0xb9, 0x94, 0xcc, 0xfa, 0x13, 0xfa, 0x04, 0x2a, 0x34, 0x5a, 0xf3, 0xdf, 0xc7, 0xc9, 0x6c,
-	0x78, 0x29, 0xd9, 0x85, 0x28, 0x6a, 0x79, 0x9a, 0xcf, 0x71, 0x61, 0xd9, 0x9d, 0x2f, 0x65, 0x44,
-	0x45, 0x8a, 0x5b, 0x9e, 0xcb, 0xca, 0x68, 0x81, 0x29, 0x59, 0x65, 0x29, 0x61, 0x2a, 0xfe, 0x4b,
-	0x55, 0x28, 0xab, 0x19, 0xa6, 0x64, 0x16, 0xa8, 0xd0, 0xef, 0x40, 0x3d, 0x94, 0x02, 0x72, 0xbd,
-	0xc6, 0x68, 0x8a, 0x29, 0xe3, 0x94, 0xab, 0x90, 0x0e, 0x28, 0x9c, 0x04, 0x72, 0xf1, 0xe3, 0x34,
-	0xc7, 0x94, 0xb1, 0xca, 0x56, 0x64, 0x75, 0x7c, 0x73, 0xb6, 0x8f, 0x5c, 0x94, 0xdd, 0x24, 0x53,
-	0x46, 0xd4, 0xad, 0xd0, 0xf7, 0xa0, 0x19, 0x2a, 0x28, 0x31, 0x16, 0x51, 0x56, 0x1a, 0xbf, 0x57,
-	0xa6, 0x4c, 0x50, 0xc9, 0x42, 0xc7, 0xb0, 0x28, 0xf2, 0x51, 0x6e, 0x9e, 0x51, 0x4d, 0x33, 0x65,
-	0x64, 0x1d, 0x0b, 0x61, 0x68, 0xb0, 0x3a, 0x13, 0xa3, 0xfb, 0x67, 0xc5, 0x78, 0xcd, 0x33, 0x65,
-	0xcc, 0xa2, 0x16, 0xb1, 0x3e, 0x5d, 0x75, 0xf1, 0x9f, 0x1e, 0xd9, 0xfd, 0x1f, 0x65, 0x44, 0x29,
-	0x06, 0x1d, 0xc1, 0x02, 0xdb, 0x2d, 0x42, 0xde, 0x88, 0x46, 0x90, 0x32, 0xaa, 0x26, 0x43, 0xbc,
-	0x3b, 0xa8, 0x9c, 0x08, 0xa9, 0x63, 0x34, 0x84, 0x94, 0x71, 0xca, 0x33, 0xc4, 0xbb, 0x43, 0x4e,
-	0x2f, 0xc4, 0x8f, 0xd3, 0x18, 0x52, 0xc6, 0x2a, 0xd3, 0xa0, 0x13, 0x58, 0x0e, 0x7b, 0xbd, 0x78,
-	0xc3, 0x58, 0x0d, 0x22, 0x65, 0xbc, 0x72, 0x0d, 0x7a, 0x08, 0x55, 0xe2, 0x9d, 0x9c, 0xc5, 0x45,
-	0x99, 0xad, 0x22, 0x25, 0xbb, 0x5e, 0x83, 0xbe, 0x03, 0x4b, 0xc2, 0x17, 0x85, 0xb2, 0x23, 0x7b,
-	0x46, 0xca, 0xe8, 0xda, 0x0d, 0xda, 0x07, 0x60, 0x6a, 0x1f, 0x19, 0x8e, 0x87, 0xb2, 0x9a, 0x47,
-	0x4a, 0x66, 0xf9, 0x06, 0xbd, 0x0f, 0x45, 0xda, 0xad, 0x41, 0x2b, 0xf2, 0xeb, 0x25, 0xca, 0x6a,
-	0x4a, 0xdf, 0x87, 0x9c, 0x29, 0xa1, 0x5f, 0x6a, 0x0b, 0x9b, 0x29, 0xf9, 0x3b, 0x70, 0x61, 0x33,
-	0xc9, 0x7e, 0xde, 0xed, 0x31, 0x54, 0xc3, 0x15, 0x18, 0x94, 0xdd, 0xca, 0x52, 0x46, 0x54, 0x93,
-	0x88, 0xd5, 0xfd, 0x1a, 0x06, 0x8f, 0x21, 0x23, 0x7b, 0xd9, 0xca, 0xe8, 0xea, 0x32, 0xfa, 0x2d,
-	0xa8, 0x05, 0xf9, 0x1f, 0x17, 0x3c, 0xba, 0xa7, 0xad, 0x8c, 0x51, 0x65, 0xf6, 0x55, 0x26, 0x78,
-	0x2e, 0x53, 0xe5, 0x50, 0x12, 0xa0, 0x8c, 0xae, 0x35, 0x07, 0x2a, 0x87, 0x04, 0x8f, 0xee, 0x71,
-	0x2b, 0x63, 0xd4, 0x9c, 0x77, 0x1a, 0xdf, 0xa5, 0xbf, 0x03, 0xf8, 0xd9, 0x96, 0x69, 0xdf, 0xed,
-	0xd8, 0xfd, 0xbe, 0x6d, 0xdd, 0x1d, 0x9c, 0x9c, 0x94, 0xe8, 0xcd, 0xcc, 0x5f, 0xfd, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xab, 0xc8, 0xa8, 0x39, 0xa0, 0x58, 0x00, 0x00,
+	// 5145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5d, 0x6c, 0x24, 0x57,
+	0x56, 0xbf, 0xdb, 0xfd, 0xe1, 0xf6, 0xe9, 0xb6, 0xdd, 0xbe, 0xee, 0xb1, 0x7b, 0xca, 0xf6, 0x8c,
+	0x53, 0xc9, 0x24, 0x93, 0xec, 0xc6, 0x33, 0x9a, 0x7f, 0x76, 0xff, 0x59, 0x6d, 0x96, 0xac, 0x3d,
+	0xee, 0xd8, 0x9d, 0xf1, 0xd8, 0xde, 0xf2, 0x38, 0x09, 0x9b, 0x85, 0x52, 0xb9, 0xfb, 0xda, 0xae,
+	0xb8, 0xbb, 0xaa, 0xb7, 0xaa, 0x7a, 0x66, 0xbc, 0x3c, 0x21, 0x21, 0xb1, 0x8f, 0x01, 0x21, 0xe0,
+	0x05, 0x81, 0x78, 0x47, 0x68, 0xe1, 0x0d, 0x01, 0x6f, 0x48, 0xbc, 0x21, 0x3e, 0x9e, 0x00, 0xed,
+	0xf2, 0x88, 0xc4, 0x33, 0x02, 0x89, 0x95, 0x40, 0xf7, 0xab, 0x3e, 0x6f, 0x55, 0x77, 0xdb, 0x9e,
+	0x49, 0x22, 0x78, 0xeb, 0xba, 0xe7, 0xdc, 0x53, 0xf7, 0x9e, 0x7b, 0xee, 0xb9, 0xbf, 0x73, 0xce,
+	0xad, 0x86, 0xd9, 0x1e, 0xf6, 0x0c, 0xd3, 0x3a, 0xb1, 0xd7, 0xfb, 0x8e, 0xed, 0xd9, 0xa8, 0x2c,
+	0x9e, 0x95, 0x1a, 0xb6, 0xda, 0xce, 0x45, 0xdf, 0x33, 0x6d, 0x8b, 0xd1, 0x14, 0x38, 0xb5, 0x4f,
+	0x39, 0x9f, 0x72, 0xfb, 0xd4, 0xb6, 0x4f, 0xbb, 0xf8, 0x1e, 0x7d, 0x3a, 0x1e, 0x9c, 0xdc, 0xf3,
+	0xcc, 0x1e, 0x76, 0x3d, 0xa3, 0xd7, 0x17, 0xcc, 0x96, 0xdd, 0xc1, 0xfc, 0xf7, 0x5c, 0xdf, 0x36,
+	0x2d, 0x0f, 0x3b, 0x9d, 0x63, 0xde, 0x50, 0xb5, 0x9d, 0x0e, 0x76, 0x5c, 0xf6, 0xa4, 0x9e, 0xc3,
+	0x8c, 0x86, 0x7f, 0x38, 0xc0, 0xae, 0xb7, 0x83, 0x8d, 0x0e, 0x76, 0xd0, 0x12, 0x4c, 0x19, 0x7d,
+	0x53, 0x3f, 0xc7, 0x17, 0x8d, 0xdc, 0x5a, 0xee, 0x6e, 0x55, 0x2b, 0x19, 0x7d, 0xf3, 0x11, 0xbe,
+	0x40, 0xab, 0x00, 0x03, 0x17, 0x3b, 0xba, 0x71, 0x8a, 0x2d, 0xaf, 0x31, 0x49, 0x69, 0xd3, 0xa4,
+	0x65, 0x83, 0x34, 0x20, 0x15, 0xaa, 0x6d, 0xa3, 0x6f, 0x1c, 0x9b, 0x5d, 0xd3, 0x33, 0xb1, 0xdb,
+	0xc8, 0xaf, 0xe5, 0xef, 0x4e, 0x6b, 0x91, 0x36, 0xf5, 0x77, 0x0b, 0x50, 0xda, 0x1c, 0xb4, 0xcf,
+	0xb1, 0x87, 0x10, 0x14, 0x2c, 0xa3, 0x87, 0xf9, 0x3b, 0xe8, 0x6f, 0xf4, 0x2e, 0x54, 0xfa, 0x86,
+	0x77, 0xa6, 0xb7, 0xcd, 0xfe, 0x19, 0x76, 0xe8, 0x2b, 0x66, 0x1f, 0x2c, 0xad, 0x87, 0x74, 0xf1,
+	0x90, 0x52, 0x0e, 0x07, 0xa6, 0x87, 0x35, 0x20, 0xbc, 0xac, 0x01, 0x3d, 0x04, 0x68, 0x3b, 0xd8,
+	0xf0, 0x70, 0x47, 0x37, 0xbc, 0x46, 0x7e, 0x2d, 0x77, 0xb7, 0xf2, 0x40, 0x59, 0x67, 0x6a, 0x5a,
+	0x17, 0x6a, 0x5a, 0x7f, 0x22, 0xd4, 0xb4, 0x59, 0xfe, 0xeb, 0x9f, 0xde, 0x9e, 0xf8, 0xfc, 0x67,
+	0xb7, 0x73, 0xda, 0x34, 0xef, 0xb7, 0xe1, 0xa1, 0xfb, 0x50, 0xef, 0xe0, 0x13, 0x63, 0xd0, 0xf5,
+	0x74, 0x17, 0x9f, 0xf6, 0xb0, 0xe5, 0xe9, 0xae, 0xf9, 0x23, 0xdc, 0x28, 0xac, 0xe5, 0xee, 0xe6,
+	0x35, 0xc4, 0x69, 0x87, 0x8c, 0x74, 0x68, 0xfe, 0x08, 0xa3, 0x8f, 0xe1, 0xa6, 0xe8, 0xe1, 0xe0,
+	0xce, 0xc0, 0xea, 0x18, 0x56, 0xfb, 0x42, 0x77, 0xdb, 0x67, 0xb8, 0x87, 0x1b, 0x45, 0x3a, 0x8a,
+	0xe5, 0xf5, 0x40, 0xff, 0x9a, 0xcf, 0x73, 0x48, 0x59, 0xb4, 0x25, 0xde, 0x3b, 0x4e, 0x40, 0x1d,
+	0x58, 0x15, 0x82, 0x83, 0xd9, 0xeb, 0x7d, 0xc3, 0x31, 0x7a, 0xd8, 0xc3, 0x8e, 0xdb, 0x28, 0x51,
+	0xe1, 0x6b, 0x61, 0xdd, 0x34, 0xfd, 0x9f, 0x07, 0x3e, 0x9f, 0xb6, 0xcc, 0xc5, 0xc8, 0x88, 0x64,
+	0x45, 0xfb, 0x86, 0xe3, 0x59, 0xd8, 0xd1, 0xcd, 0x4e, 0x63, 0x8a, 0xad, 0x28, 0x6f, 0x69, 0x75,
+	0xd0, 0x3b, 0x00, 0x4f, 0xb1, 0xe3, 0x9a, 0xb6, 0x65, 0x5a, 0xa7, 0x8d, 0x32, 0x5d, 0x8d, 0xfa,
+	0xba, 0x6f, 0xb3, 0x1f, 0xf9, 0x34, 0x2d, 0xc4, 0x87, 0xbe, 0x01, 0x15, 0xfb, 0xf8, 0x33, 0xdc,
+	0xf6, 0xf4, 0xae, 0xdd, 0x3e, 0x6f, 0x4c, 0xd3, 0x81, 0x86, 0xba, 0xed, 0x53, 0xe2, 0xae, 0xdd,
+	0x3e, 0xd7, 0xc0, 0xf6, 0x7f, 0xab, 0x3f, 0x00, 0x08, 0x28, 0xa8, 0x01, 0x53, 0xd8, 0x32, 0x8e,
+	0xbb, 0xb8, 0x43, 0x0d, 0xa4, 0xac, 0x89, 0x47, 0xf4, 0x0e, 0x2c, 0x06, 0x2a, 0xf7, 0xb0, 0x45,
+	0x15, 0xd3, 0x31, 0x2e, 0x5c, 0x6a, 0x2e, 0x45, 0xad, 0xee, 0xab, 0x94, 0x13, 0xb7, 0x8c, 0x0b,
+	0x57, 0xfd, 0x71, 0x0e, 0x66, 0x99, 0xe1, 0xed, 0x9a, 0xae, 0xd7, 0xf2, 0x70, 0x4f, 0x6a, 0x80,
+	0x51, 0x13, 0xcf, 0xc7, 0x4d, 0x3c, 0x6a, 0x65, 0x93, 0x97, 0xb2, 0x32, 0xf5, 0x37, 0x0a, 0xb0,
+	0xc0, 0x86, 0xf2, 0x90, 0xb6, 0xf1, 0xdd, 0x87, 0xee, 0x41, 0xe9, 0x8c, 0xee, 0xc0, 0xc6, 0x1c,
+	0x15, 0xbc, 0x14, 0xa8, 0x2c, 0xb2, 0x41, 0x35, 0xce, 0x76, 0xcd, 0x3b, 0x28, 0xcd, 0xf8, 0xf3,
+	0x97, 0x33, 0xfe, 0xc2, 0x8b, 0x34, 0xfe, 0xe2, 0xf5, 0x1b, 0x7f, 0x29, 0xdb, 0xf8, 0xa7, 0x2e,
+	0x67, 0xfc, 0xe5, 0x11, 0x8d, 0xff, 0xbb, 0x50, 0x8f, 0x9a, 0x84, 0xdb, 0xb7, 0x2d, 0x17, 0xa3,
+	0xbb, 0x50, 0x3a, 0xa6, 0xed, 0x74, 0x91, 0x2b, 0x0f, 0x6a, 0x81, 0x24, 0xc6, 0xaf, 0x71, 0xba,
+	0xfa, 0x31, 0xd4, 0x58, 0xcb, 0x36, 0xf6, 0xae, 0xd3, 0xa2, 0xd4, 0xef, 0xc0, 0x7c, 0x48, 0xf0,
+	0xd8, 0xe3, 0xba, 0x10, 0xc6, 0xbe, 0x85, 0xbb, 0xf8, 0x9a, 0x8d, 0x7d, 0x15, 0xa0, 0x43, 0xa5,
+	0xea, 0x46, 0xb7, 0x4b, 0x6d, 0xbd, 0xac, 0x4d, 0xb3, 0x96, 0x8d, 0x6e, 0x57, 0xf5, 0xa0, 0x1e,
+	0x7d, 0xf5, 0xb8, 0x83, 0x47, 0x0f, 0xe0, 0x06, 0x13, 0xd7, 0xd1, 0xd9, 0x62, 0xb9, 0x7a, 0xdb,
+	0x1e, 0xf0, 0xc3, 0x2f, 0xaf, 0x2d, 0x70, 0x22, 0x5b, 0x54, 0xf7, 0x21, 0x21, 0xa9, 0x9f, 0xe7,
+	0x60, 0x3e, 0xf0, 0x34, 0x97, 0x9e, 0xef, 0x22, 0x94, 0xda, 0x03, 0xc7, 0xb5, 0x1d, 0x71, 0x08,
+	0xb3, 0x27, 0x54, 0x87, 0x62, 0xd7, 0xec, 0x99, 0x1e, 0xf7, 0x76, 0xec, 0x01, 0xad, 0xc0, 0x74,
+	0xc7, 0x74, 0x70, 0x9b, 0x98, 0x38, 0xdd, 0xb1, 0x45, 0x2d, 0x68, 0x50, 0x3f, 0x01, 0x14, 0x1e,
+	0x11, 0x57, 0xc3, 0x3a, 0x14, 0x4d, 0x0f, 0xf7, 0xdc, 0x46, 0x6e, 0x2d, 0x7f, 0xb7, 0xf2, 0xa0,
+	0x11, 0xd7, 0x82, 0x70, 0x94, 0x1a, 0x63, 0x23, 0x2b, 0xd0, 0xb3, 0x1d, 0xcc, 0xf5, 0x4c, 0x7f,
+	0xab, 0xbf, 0x9a, 0x83, 0x65, 0xc6, 0x7d, 0x88, 0xbd, 0x0d, 0xcf, 0x73, 0xcc, 0xe3, 0x01, 0x79,
+	0xe5, 0x75, 0x2f, 0x73, 0x68, 0xa3, 0x4e, 0xc6, 0x36, 0xaa, 0x7a, 0x0b, 0x56, 0xe4, 0x43, 0x60,
+	0xf3, 0x54, 0x7f, 0x27, 0x07, 0x8a, 0xcf, 0x10, 0xda, 0xb6, 0xd7, 0x39, 0xc4, 0xa8, 0xb3, 0x98,
+	0x1c, 0xcd, 0x59, 0xa8, 0xdb, 0x21, 0xe5, 0x85, 0x07, 0x36, 0xf6, 0x26, 0xfb, 0xb5, 0x1c, 0x2c,
+	0x6c, 0x74, 0x3a, 0x0e, 0x76, 0x5d, 0xdc, 0xd9, 0x27, 0xe8, 0x6e, 0x97, 0x9a, 0xc5, 0x5d, 0x61,
+	0x2c, 0x4c, 0x00, 0x5a, 0xe7, 0xc8, 0x2f, 0x60, 0x11, 0x06, 0xf4, 0x10, 0xea, 0xae, 0x67, 0x3b,
+	0xc6, 0x29, 0xd6, 0x2d, 0xbb, 0x83, 0x75, 0x83, 0x49, 0xe3, 0x67, 0xdc, 0xfc, 0x3a, 0x69, 0x5c,
+	0xdf, 0xb3, 0x3b, 0x98, 0xbf, 0x46, 0x43, 0x9c, 0x3d, 0xd4, 0xa6, 0x36, 0x01, 0x1d, 0x38, 0x36,
+	0xd9, 0x0b, 0x2d, 0xeb, 0xc4, 0xbe, 0xac, 0x82, 0xd5, 0x77, 0x61, 0x21, 0x22, 0x86, 0xab, 0xe3,
+	0x15, 0xa8, 0xf6, 0x59, 0xb3, 0xee, 0x1a, 0x5d, 0x8f, 0xeb, 0xbf, 0xc2, 0xdb, 0x0e, 0x8d, 0xae,
+	0xa7, 0xfe, 0x59, 0x19, 0x4a, 0x6c, 0x33, 0x92, 0xfd, 0x13, 0x52, 0x5e, 0xd5, 0xdf, 0xd2, 0x77,
+	0x60, 0x96, 0x9f, 0x1a, 0xb8, 0xa3, 0x93, 0xe3, 0x8f, 0x1b, 0xd4, 0x8c, 0xdf, 0x7a, 0x60, 0x78,
+	0x67, 0x04, 0x7f, 0xf0, 0x85, 0xe2, 0xdb, 0x49, 0x3c, 0x92, 0xe9, 0xb8, 0x9e, 0xe1, 0x0d, 0xdc,
+	0x46, 0x81, 0x1f, 0xae, 0x31, 0xe7, 0xbe, 0x7e, 0x48, 0xc9, 0x1a, 0x67, 0x43, 0x6f, 0xc3, 0xb4,
+	0xeb, 0x39, 0xd8, 0xe8, 0x11, 0xeb, 0x25, 0x27, 0x57, 0x75, 0xb3, 0x46, 0x70, 0xc1, 0x3f, 0xfe,
+	0xf4, 0x76, 0xf9, 0x90, 0x12, 0x5a, 0x5b, 0x5a, 0x99, 0xb1, 0xb4, 0x3a, 0x31, 0x8c, 0x51, 0xba,
+	0x1c, 0x92, 0xdd, 0x80, 0x69, 0xf6, 0x76, 0x22, 0x63, 0x6a, 0x0c, 0x19, 0x65, 0xd6, 0x6d, 0x83,
+	0x62, 0x1d, 0xfc, 0xbc, 0x6f, 0x3a, 0x98, 0xca, 0x28, 0x8f, 0x33, 0x0e, 0xde, 0x6f, 0xc3, 0x43,
+	0xdb, 0xd0, 0x08, 0xb4, 0x4d, 0xf4, 0xd4, 0x31, 0x3c, 0x43, 0xb7, 0x6c, 0xab, 0x8d, 0x29, 0x30,
+	0xac, 0x6e, 0xce, 0x70, 0x55, 0x14, 0xf7, 0x48, 0xa3, 0xb6, 0xe8, 0xb3, 0x3f, 0xe6, 0xdc, 0xb4,
+	0x1d, 0xbd, 0x0d, 0x28, 0x29, 0xa8, 0x01, 0x74, 0xe9, 0xe6, 0x13, 0x7d, 0xd0, 0x36, 0xac, 0x49,
+	0xde, 0x1b, 0x34, 0x91, 0xe0, 0x66, 0x9e, 0x76, 0x5e, 0x4d, 0x74, 0x6e, 0x8a, 0x06, 0x12, 0xf3,
+	0x7c, 0x1d, 0xd0, 0x89, 0xf9, 0x1c, 0x77, 0xa2, 0x98, 0xa8, 0x42, 0xdd, 0x7f, 0x8d, 0x52, 0xc2,
+	0x88, 0x68, 0x07, 0xe6, 0x93, 0x48, 0xa8, 0x3a, 0x1c, 0x09, 0xd5, 0x9c, 0x58, 0x0b, 0x3a, 0x82,
+	0x1b, 0x72, 0xe8, 0x33, 0x33, 0x22, 0xf4, 0xa9, 0xe3, 0x14, 0xcc, 0xe3, 0xd9, 0x9e, 0xd1, 0x65,
+	0xd3, 0x98, 0xa5, 0xd3, 0x98, 0xa6, 0x2d, 0x74, 0xfc, 0xb7, 0xa1, 0x62, 0x5a, 0x5d, 0xd3, 0xc2,
+	0x8c, 0x3e, 0x47, 0xe9, 0xc0, 0x9a, 0x04, 0x83, 0x83, 0x7b, 0xb6, 0xc7, 0x19, 0x6a, 0x8c, 0x81,
+	0x35, 0x51, 0x06, 0xe2, 0xab, 0xbb, 0x86, 0x69, 0x31, 0x3a, 0x62, 0x2f, 0xa0, 0x2d, 0x94, 0xbc,
+	0x0d, 0x55, 0x87, 0xee, 0x16, 0x7d, 0x60, 0x79, 0x66, 0xb7, 0xb1, 0x30, 0x86, 0x59, 0x55, 0x58,
+	0xcf, 0x23, 0xd2, 0x51, 0xfd, 0x1e, 0x94, 0xd8, 0x36, 0x43, 0x15, 0x98, 0x6a, 0xed, 0x7d, 0xb4,
+	0xb1, 0xdb, 0xda, 0xaa, 0x4d, 0xa0, 0x19, 0x98, 0x3e, 0x3a, 0xd8, 0xdd, 0xdf, 0xd8, 0x6a, 0xed,
+	0x6d, 0xd7, 0x72, 0x68, 0x16, 0xe0, 0xe1, 0xfe, 0xe3, 0xc7, 0xad, 0x27, 0x4f, 0xc8, 0xf3, 0x24,
+	0x21, 0xf3, 0xe7, 0xe6, 0x56, 0x2d, 0x8f, 0xaa, 0x50, 0xde, 0x6a, 0xee, 0x36, 0x29, 0xb1, 0xa0,
+	0xfe, 0x55, 0x01, 0x10, 0xdb, 0xc1, 0x9b, 0xf8, 0xd4, 0xb4, 0xae, 0x72, 0x72, 0xbf, 0x18, 0xcf,
+	0x13, 0xdd, 0x91, 0x85, 0xcb, 0xed, 0x48, 0xa9, 0x89, 0x4e, 0x5d, 0xab, 0x89, 0x96, 0xaf, 0x64,
+	0xa2, 0x5f, 0x66, 0x97, 0x51, 0x19, 0xc1, 0x65, 0xa8, 0x7f, 0x39, 0x09, 0x0b, 0x11, 0x3b, 0xe2,
+	0xe7, 0xd7, 0x0b, 0xb3, 0x8b, 0xc8, 0x01, 0x53, 0x18, 0x7a, 0xc0, 0x48, 0x2d, 0xa0, 0x78, 0xad,
+	0x16, 0x50, 0xba, 0x8a, 0x05, 0xa8, 0xff, 0xed, 0x2b, 0xf0, 0xa1, 0xdd, 0x23, 0x18, 0xe5, 0xb2,
+	0x3b, 0x31, 0xa2, 0x98, 0xdc, 0x50, 0xc5, 0x6c, 0xc3, 0x9a, 0x7b, 0x6e, 0xf6, 0x75, 0xfb, 0x29,
+	0x76, 0x1c, 0xb3, 0x83, 0x75, 0x89, 0xf9, 0x14, 0x29, 0xf8, 0x5d, 0x25, 0x7c, 0xfb, 0x9c, 0xad,
+	0x29, 0x31, 0xa5, 0x74, 0x13, 0x9e, 0xbc, 0xba, 0x09, 0xe7, 0xaf, 0x62, 0xc2, 0x85, 0x51, 0x4c,
+	0x78, 0x11, 0xea, 0xd1, 0x05, 0xe0, 0x50, 0xfa, 0x6f, 0x73, 0x70, 0x9b, 0x47, 0xb0, 0xa6, 0xeb,
+	0x1d, 0x60, 0xab, 0x63, 0x5a, 0xa7, 0x4c, 0x93, 0xee, 0x17, 0xe5, 0x2f, 0xef, 0x42, 0xcd, 0x5f,
+	0x64, 0x9d, 0x87, 0x4c, 0x4c, 0x43, 0xb3, 0x62, 0x65, 0x1f, 0xc6, 0x42, 0xa7, 0x42, 0x28, 0x74,
+	0x52, 0x4f, 0x60, 0x2d, 0x7d, 0x4a, 0x43, 0x43, 0xa5, 0xa0, 0xeb, 0xb0, 0x50, 0xe9, 0x6f, 0x72,
+	0x70, 0x83, 0x71, 0x6f, 0xd9, 0xcf, 0xac, 0xae, 0x6d, 0x74, 0xae, 0x5d, 0x63, 0xf7, 0xa1, 0x1e,
+	0x68, 0x8c, 0xa7, 0x21, 0xc8, 0x9a, 0x33, 0xbd, 0x05, 0xa6, 0xc4, 0x86, 0x41, 0xe0, 0x8d, 0x54,
+	0x25, 0xe8, 0x0e, 0x14, 0x1d, 0xc3, 0x3a, 0xc5, 0x3c, 0x8f, 0x3a, 0x17, 0x1a, 0x0f, 0x69, 0xd6,
+	0x18, 0x55, 0xfd, 0xa3, 0x1c, 0x14, 0x69, 0x03, 0x7a, 0x0f, 0x2a, 0xae, 0x67, 0x38, 0x9e, 0x1e,
+	0x8e, 0x36, 0x6e, 0xc6, 0xba, 0x1d, 0x12, 0x0e, 0x1a, 0x74, 0xec, 0x4c, 0x68, 0xe0, 0xfa, 0x4f,
+	0xe8, 0xeb, 0x50, 0xa4, 0x4f, 0x3c, 0xd8, 0xa8, 0xcb, 0xfa, 0xed, 0x4c, 0x68, 0x8c, 0x89, 0xe2,
+	0xef, 0xc1, 0xc9, 0x89, 0xf9, 0x9c, 0x8f, 0xee, 0x46, 0x9c, 0x9d, 0x12, 0x77, 0x26, 0x34, 0xce,
+	0xb6, 0x39, 0xc5, 0x47, 0xa9, 0x1e, 0xc2, 0x5c, 0x6c, 0x20, 0x04, 0xcf, 0x70, 0xb8, 0x42, 0x07,
+	0x90, 0x63, 0x78, 0x86, 0x36, 0x51, 0xae, 0x80, 0x21, 0x08, 0xba, 0x05, 0x03, 0x95, 0xa0, 0xbe,
+	0x0d, 0x10, 0x08, 0x1d, 0x2a, 0x4f, 0xbd, 0x0f, 0x95, 0xd0, 0x28, 0x69, 0x4c, 0xc3, 0xf8, 0xd9,
+	0x94, 0x58, 0x07, 0x26, 0x83, 0xb1, 0xa8, 0x7f, 0x97, 0x83, 0xc5, 0xb8, 0xdd, 0x04, 0x01, 0x22,
+	0x5b, 0xe5, 0x64, 0x80, 0xc8, 0x7a, 0x68, 0x9c, 0x8e, 0xbe, 0x0b, 0x55, 0x01, 0x60, 0xbb, 0xa6,
+	0x2b, 0x34, 0xbd, 0x1a, 0xf0, 0x73, 0x14, 0x1b, 0x4e, 0x10, 0x68, 0x15, 0x37, 0x68, 0x44, 0xbb,
+	0x50, 0x13, 0x12, 0x3a, 0x7c, 0x1c, 0x34, 0xc3, 0x5f, 0x79, 0xf0, 0x4a, 0x42, 0x4a, 0x7c, 0xa0,
+	0xda, 0x9c, 0x1b, 0x25, 0xa8, 0x3f, 0xcb, 0x41, 0x8d, 0x0d, 0xf1, 0x2a, 0xe9, 0xaa, 0x17, 0x76,
+	0xa2, 0x6e, 0xc0, 0x6a, 0xe2, 0x88, 0xd4, 0xfb, 0xd8, 0x11, 0x51, 0x00, 0xdd, 0x2e, 0x65, 0x4d,
+	0x89, 0x9f, 0x88, 0x07, 0xd8, 0xe1, 0x2a, 0x20, 0x69, 0xb3, 0xd0, 0x04, 0xc7, 0x5d, 0x30, 0xf5,
+	0x9f, 0x0b, 0xa2, 0xff, 0x55, 0xb3, 0x48, 0x52, 0x0d, 0xbd, 0x09, 0xb5, 0x90, 0x86, 0x1c, 0x4c,
+	0x6c, 0x8f, 0xe9, 0x68, 0x2e, 0xd0, 0x11, 0x6d, 0x8e, 0xb2, 0x46, 0xfc, 0x6b, 0xc0, 0xca, 0x1d,
+	0xec, 0x0a, 0x4c, 0x3b, 0x98, 0xb0, 0x98, 0x4f, 0x31, 0x57, 0x51, 0xd0, 0x10, 0xf8, 0x9a, 0x62,
+	0xd8, 0xd7, 0x04, 0xe1, 0xf4, 0xd4, 0x68, 0xe1, 0x74, 0x0b, 0xe6, 0xb8, 0x6b, 0x33, 0xad, 0x76,
+	0x77, 0xd0, 0xc1, 0x01, 0xdc, 0x48, 0xf1, 0xca, 0x2d, 0xce, 0xa7, 0xcd, 0xb2, 0x8e, 0xe2, 0x19,
+	0xad, 0xc3, 0xc2, 0xc0, 0xc5, 0x7a, 0x5c, 0x5c, 0x99, 0x8e, 0x7c, 0x7e, 0xe0, 0xe2, 0xfd, 0x28,
+	0xff, 0x7d, 0xa8, 0x73, 0x26, 0x92, 0x70, 0xd4, 0xb9, 0xb5, 0xb8, 0x14, 0x96, 0x96, 0x35, 0xc4,
+	0x69, 0x1b, 0xdd, 0x2e, 0x4f, 0xe6, 0x90, 0xc1, 0xce, 0xf8, 0xc1, 0xfc, 0x89, 0x87, 0x9d, 0x06,
+	0x8c, 0x81, 0xda, 0xab, 0x22, 0x9e, 0x27, 0x3d, 0xd1, 0x23, 0x98, 0x15, 0xa2, 0x8e, 0xf1, 0x09,
+	0x39, 0x5d, 0x2a, 0x63, 0xc8, 0x12, 0xc3, 0xd8, 0xa4, 0x5d, 0x49, 0x46, 0x30, 0x6c, 0x5d, 0xd7,
+	0x78, 0xcc, 0xfd, 0x47, 0x01, 0x66, 0xa3, 0xdc, 0x92, 0xed, 0x98, 0x1b, 0xb2, 0x1d, 0x27, 0xd3,
+	0x52, 0x2e, 0xf9, 0xd1, 0x6c, 0x24, 0x9a, 0x43, 0x29, 0x5c, 0x43, 0x0e, 0xa5, 0x78, 0x0d, 0x39,
+	0x94, 0xd2, 0xf5, 0xe7, 0x50, 0xa6, 0xc6, 0x41, 0x93, 0xd7, 0x15, 0xe1, 0xa4, 0xc0, 0xd2, 0x72,
+	0x1a, 0x2c, 0x8d, 0xe6, 0x04, 0x20, 0x9e, 0x13, 0x78, 0x33, 0x8c, 0xd2, 0x59, 0x84, 0x57, 0x4d,
+	0x41, 0xe8, 0xcb, 0x30, 0x6d, 0xba, 0x7a, 0xd7, 0xf0, 0xb0, 0xeb, 0xd1, 0xbc, 0x4a, 0x59, 0x2b,
+	0x9b, 0xee, 0x2e, 0x7d, 0x56, 0xbb, 0xb0, 0x18, 0x35, 0x3c, 0x7f, 0xdf, 0x2a, 0x50, 0xf6, 0x47,
+	0xc9, 0xaa, 0x89, 0xfe, 0x33, 0xfa, 0x26, 0x2c, 0xe1, 0xe7, 0x6c, 0x4f, 0xbb, 0x17, 0xae, 0x87,
+	0x7b, 0xc1, 0x84, 0x98, 0x59, 0xdf, 0xe0, 0xe4, 0x43, 0x4a, 0x15, 0x93, 0x52, 0xff, 0x2d, 0x07,
+	0x8d, 0x50, 0x94, 0x77, 0xc5, 0xea, 0xc6, 0x0b, 0x3b, 0xc9, 0x16, 0x23, 0xd9, 0xca, 0xe2, 0xb0,
+	0xa4, 0x64, 0x4e, 0xae, 0x78, 0xd5, 0x83, 0x9b, 0x92, 0xc9, 0x72, 0xb7, 0x31, 0x66, 0x98, 0x15,
+	0x1c, 0x82, 0x93, 0x43, 0x0e, 0xc1, 0x5f, 0x11, 0x6f, 0xfd, 0xc0, 0xb4, 0x4c, 0xf7, 0xec, 0x8a,
+	0x3a, 0x1e, 0x6f, 0x98, 0xea, 0x0a, 0x28, 0xb2, 0x97, 0xf3, 0x48, 0xe8, 0xc7, 0x39, 0x11, 0x22,
+	0xb9, 0x2f, 0x68, 0xe9, 0xdf, 0x80, 0xb9, 0xe8, 0xd2, 0x93, 0x64, 0x7c, 0x9e, 0x84, 0x35, 0x91,
+	0xb5, 0x77, 0x55, 0x0d, 0x6e, 0xc4, 0x46, 0xc2, 0xd7, 0xe5, 0x5b, 0x51, 0x77, 0xfe, 0x6a, 0x5c,
+	0xcf, 0x31, 0xfe, 0x90, 0x67, 0x57, 0xff, 0x2b, 0x07, 0x37, 0x53, 0x99, 0x46, 0x75, 0xe8, 0x9b,
+	0xbe, 0xed, 0xb1, 0x82, 0xc8, 0x5b, 0x23, 0x0c, 0x20, 0xee, 0xc9, 0x03, 0x63, 0xc9, 0x0f, 0x31,
+	0x96, 0x96, 0x3c, 0x23, 0x58, 0x81, 0x29, 0x9a, 0xe3, 0x6b, 0x6e, 0xd5, 0x72, 0x24, 0xff, 0xb7,
+	0xb7, 0xff, 0x44, 0xff, 0x60, 0xff, 0x68, 0x6f, 0xab, 0x36, 0x89, 0x00, 0x4a, 0x1f, 0x6c, 0xb4,
+	0x76, 0x69, 0x2e, 0x10, 0xa0, 0xb4, 0xbb, 0xff, 0xf0, 0x51, 0x73, 0xab, 0x56, 0x50, 0xff, 0x33,
+	0x27, 0xd6, 0xfe, 0x60, 0xe0, 0x9c, 0x62, 0x71, 0x9a, 0x7f, 0x51, 0xbb, 0x7b, 0x3b, 0x71, 0xf2,
+	0x0f, 0xbf, 0xdf, 0x52, 0x90, 0x9c, 0xfa, 0x24, 0xda, 0x88, 0xe0, 0x16, 0x06, 0xd1, 0x2a, 0x46,
+	0x00, 0x58, 0xd4, 0x43, 0x58, 0x96, 0xce, 0x9c, 0x9b, 0x14, 0xbd, 0x7c, 0xc1, 0x0a, 0xa2, 0x42,
+	0x0a, 0xaf, 0x88, 0xb2, 0xc8, 0xa5, 0xce, 0xa9, 0xa2, 0x23, 0x2b, 0x89, 0xfe, 0x7e, 0x4e, 0x24,
+	0x74, 0xb6, 0xb1, 0xd7, 0x3a, 0x70, 0xbf, 0x74, 0x6e, 0x52, 0xfd, 0x03, 0x7f, 0x3b, 0x8b, 0x11,
+	0xf2, 0x09, 0xd7, 0x20, 0x6f, 0xf6, 0xd9, 0x0e, 0xaa, 0x6a, 0xe4, 0x27, 0x7a, 0x15, 0x66, 0x44,
+	0x20, 0x14, 0xae, 0x05, 0x8b, 0xf8, 0x8a, 0xce, 0x98, 0xc6, 0x81, 0x26, 0x6e, 0x63, 0xce, 0x92,
+	0xe7, 0x71, 0x20, 0x69, 0x62, 0x0c, 0xf7, 0xa1, 0xee, 0xe0, 0xae, 0x49, 0xae, 0xb4, 0xe8, 0x61,
+	0x4e, 0x7e, 0xd5, 0x48, 0xd0, 0x0e, 0xfc, 0x1e, 0xea, 0x1f, 0xe6, 0xc5, 0xd2, 0x1c, 0xf5, 0x3b,
+	0x86, 0x87, 0xc5, 0x49, 0xf4, 0x25, 0xc8, 0x22, 0x8c, 0x98, 0x9a, 0x9c, 0x1a, 0x21, 0x03, 0x97,
+	0x0e, 0x75, 0x0a, 0x57, 0x4f, 0x9c, 0x15, 0xaf, 0x92, 0x38, 0x2b, 0x8d, 0x92, 0x38, 0xbb, 0x05,
+	0x2b, 0xf2, 0x35, 0xe2, 0xc7, 0xc6, 0x27, 0x50, 0x39, 0x34, 0x3c, 0x31, 0x73, 0x3f, 0x3c, 0x60,
+	0x57, 0x98, 0x3c, 0xdc, 0x28, 0x8e, 0x1d, 0x1e, 0xd0, 0x0b, 0x4e, 0x1e, 0x56, 0xff, 0x65, 0x12,
+	0xa6, 0x78, 0xec, 0x39, 0xee, 0x81, 0xfc, 0x0d, 0x28, 0xf7, 0x6d, 0xd7, 0xf4, 0x04, 0xf2, 0x8e,
+	0xa4, 0x6e, 0xb8, 0xcc, 0x03, 0xce, 0xa0, 0xf9, 0xac, 0xe8, 0x3b, 0xb0, 0x10, 0xd1, 0x10, 0x5f,
+	0xa7, 0xbc, 0x6c, 0x9d, 0x02, 0x9d, 0x3f, 0xc2, 0x17, 0x6c, 0x89, 0x5e, 0x85, 0x19, 0x59, 0x66,
+	0xb2, 0x1a, 0xe6, 0x24, 0x11, 0x1a, 0x01, 0x8d, 0xa1, 0xa5, 0xf0, 0x17, 0x32, 0xaf, 0xcd, 0x13,
+	0x92, 0xaf, 0xfe, 0x2d, 0xb2, 0x90, 0x0f, 0xfc, 0x8c, 0x34, 0xee, 0xe8, 0xbc, 0x94, 0x45, 0x7b,
+	0xb0, 0xd5, 0x0b, 0x06, 0xdc, 0xa2, 0x34, 0xda, 0xe7, 0x0d, 0x28, 0xd1, 0x1d, 0x48, 0x22, 0xd0,
+	0x7c, 0x34, 0xdd, 0x45, 0xb7, 0x9f, 0xc6, 0xc9, 0xea, 0x0e, 0x14, 0x69, 0x03, 0x81, 0xa1, 0x6c,
+	0xcf, 0x5a, 0x83, 0x1e, 0xd5, 0x6f, 0x51, 0x2b, 0xd3, 0x86, 0xbd, 0x41, 0x0f, 0xa9, 0x50, 0xb0,
+	0xec, 0x8e, 0x48, 0xf4, 0xce, 0x72, 0x3d, 0x94, 0x48, 0x9d, 0xbc, 0xb5, 0xa5, 0x51, 0x9a, 0xba,
+	0x03, 0x73, 0x31, 0xbd, 0x52, 0x8f, 0x41, 0x32, 0x68, 0xd6, 0xa0, 0x77, 0x8c, 0x1d, 0x2e, 0x95,
+	0xde, 0x7b, 0xd8, 0xa3, 0x2d, 0x24, 0x7c, 0x36, 0xad, 0x0e, 0x7e, 0x2e, 0x2e, 0x7e, 0xd0, 0x07,
+	0xf5, 0x1f, 0x72, 0xb0, 0xc0, 0x45, 0x5d, 0xad, 0x6a, 0xf5, 0x72, 0x6c, 0xe6, 0x75, 0x98, 0xeb,
+	0x19, 0xcf, 0x75, 0x7a, 0x0d, 0x81, 0xa7, 0xd4, 0x98, 0x6f, 0x9c, 0xe9, 0x19, 0xcf, 0x83, 0x5b,
+	0x09, 0xea, 0x6f, 0x4f, 0x42, 0x3d, 0x3a, 0x2d, 0xee, 0x8f, 0xef, 0x03, 0x08, 0xef, 0xeb, 0x8f,
+	0x73, 0x9e, 0x8f, 0x73, 0x9a, 0xf7, 0x68, 0x6d, 0x69, 0xd3, 0x9c, 0x89, 0x96, 0x3b, 0x6a, 0x86,
+	0xb8, 0x1a, 0xc1, 0x5e, 0xc9, 0x80, 0x54, 0x24, 0xfd, 0x25, 0xb9, 0x3c, 0xa1, 0xcd, 0xf9, 0xdd,
+	0xe8, 0xb3, 0x4b, 0xef, 0xd6, 0x39, 0xe6, 0x53, 0xc3, 0xc3, 0xd4, 0x5e, 0x99, 0xa1, 0x2f, 0xf1,
+	0x97, 0xcf, 0x51, 0xd3, 0x38, 0x60, 0xf4, 0x47, 0xf8, 0x42, 0x83, 0xbe, 0xff, 0x5b, 0x5e, 0x72,
+	0x29, 0x5c, 0xa2, 0xe4, 0xa2, 0xfe, 0x5e, 0xde, 0x57, 0xcc, 0x15, 0x8b, 0x23, 0xe3, 0x6b, 0x32,
+	0x65, 0xc3, 0x4f, 0x5e, 0x76, 0xc3, 0xe7, 0x47, 0xdf, 0xf0, 0x85, 0xb4, 0x0d, 0x1f, 0x8d, 0x2d,
+	0x4b, 0xf1, 0xd8, 0xf2, 0xf5, 0x30, 0x88, 0xc6, 0xba, 0x67, 0x9c, 0xf2, 0x5b, 0xae, 0xc1, 0x50,
+	0x9a, 0x4f, 0x8c, 0x53, 0xb4, 0x0d, 0x33, 0x83, 0x3e, 0xc9, 0x4c, 0xea, 0x0e, 0x76, 0x07, 0x5d,
+	0x12, 0xef, 0x13, 0x0b, 0x51, 0x93, 0x36, 0x4d, 0x56, 0xf9, 0xa8, 0xcf, 0xb3, 0x9b, 0xe4, 0xf2,
+	0x62, 0x75, 0x10, 0x7a, 0x52, 0x7f, 0x3d, 0x07, 0x8d, 0x34, 0xd6, 0x6c, 0xbf, 0xf1, 0x06, 0x4c,
+	0xd1, 0x9b, 0x37, 0x66, 0x27, 0xc5, 0x75, 0x94, 0x08, 0xb9, 0xd5, 0x41, 0x77, 0xa0, 0x70, 0x66,
+	0xb8, 0x67, 0x1c, 0x04, 0xce, 0x8b, 0x3b, 0x3d, 0xf4, 0x75, 0x3b, 0x86, 0x7b, 0xa6, 0x51, 0xb2,
+	0xba, 0x05, 0x37, 0x62, 0x86, 0xc2, 0xb7, 0xd0, 0xd7, 0x60, 0xde, 0x1d, 0xb4, 0xdb, 0xd8, 0x75,
+	0x4f, 0x06, 0x5d, 0x9d, 0xbb, 0x3e, 0x36, 0x9a, 0x5a, 0x40, 0x38, 0x60, 0x3e, 0xef, 0xf3, 0xbc,
+	0x3f, 0x9f, 0xc7, 0xc6, 0x39, 0x66, 0x6e, 0xf3, 0x4b, 0xee, 0x64, 0x5e, 0xc6, 0xc1, 0x94, 0x7a,
+	0xd0, 0x14, 0xd3, 0x0f, 0x9a, 0xeb, 0xb1, 0x55, 0x75, 0x19, 0x6e, 0x4a, 0x56, 0x84, 0x03, 0x8c,
+	0x3f, 0xc9, 0xc1, 0xcd, 0xb0, 0xe3, 0x7c, 0xa9, 0x31, 0xf3, 0x25, 0x17, 0x8c, 0x94, 0x38, 0x14,
+	0xd9, 0xa0, 0xbf, 0xca, 0x3e, 0x5f, 0xfd, 0x8b, 0x60, 0x52, 0xd7, 0x92, 0xbe, 0x18, 0x5f, 0x0b,
+	0xef, 0xc1, 0x14, 0xf3, 0x66, 0x62, 0xf2, 0x29, 0xee, 0xcc, 0x57, 0x37, 0x71, 0x67, 0xa2, 0x4b,
+	0xc2, 0x93, 0x85, 0xb9, 0x5e, 0xae, 0x27, 0x5b, 0x85, 0x65, 0xa9, 0x22, 0xb9, 0xc9, 0xff, 0x7b,
+	0x0e, 0x50, 0xa4, 0x7c, 0xf5, 0x72, 0x6c, 0x7d, 0x13, 0xe6, 0x58, 0x35, 0x44, 0x1f, 0xdd, 0xe4,
+	0x67, 0x59, 0x0f, 0xf1, 0x1c, 0x94, 0x44, 0xf2, 0xd2, 0xf2, 0x6b, 0x21, 0xb3, 0xfc, 0xfa, 0x93,
+	0x00, 0xfa, 0x45, 0xb2, 0xf8, 0xf7, 0xa2, 0x69, 0x9f, 0x9b, 0xd2, 0x22, 0xdf, 0x90, 0x34, 0x7e,
+	0xfa, 0xd5, 0x8e, 0xfc, 0x95, 0xae, 0x76, 0xfc, 0xd3, 0x24, 0xcc, 0xc5, 0x46, 0x11, 0x71, 0x1a,
+	0xb9, 0xd1, 0xbd, 0x7c, 0xd4, 0x9b, 0x4e, 0xc6, 0xbd, 0xa9, 0x5f, 0x59, 0xb5, 0x4f, 0x4e, 0x5c,
+	0x2c, 0x02, 0x6b, 0x56, 0x59, 0xdd, 0xa7, 0x4d, 0xd7, 0xf3, 0xcd, 0x90, 0xc4, 0x6b, 0x17, 0x65,
+	0x08, 0x23, 0xe5, 0x50, 0x2a, 0x5d, 0xf6, 0x50, 0x9a, 0x4a, 0x1e, 0x4a, 0xea, 0x9f, 0xe7, 0x60,
+	0x31, 0x51, 0x82, 0xfd, 0xca, 0xec, 0x06, 0xf5, 0xe7, 0x05, 0x58, 0x4a, 0xa9, 0x20, 0x7f, 0x45,
+	0x71, 0x7f, 0x2a, 0x4a, 0x28, 0xa4, 0xa3, 0x84, 0xb8, 0xe1, 0x56, 0x92, 0x86, 0x1b, 0x35, 0xfd,
+	0xaa, 0xc4, 0xf4, 0x23, 0xb7, 0x55, 0x59, 0xb4, 0x2c, 0xaa, 0xf9, 0x94, 0xe5, 0x25, 0x58, 0xa3,
+	0x3c, 0xe8, 0x99, 0xbe, 0xcc, 0x3d, 0xb3, 0xb7, 0xa1, 0x60, 0xe1, 0xe7, 0xe2, 0x12, 0x72, 0x86,
+	0x45, 0x51, 0xb6, 0x88, 0x43, 0x81, 0xd1, 0x51, 0xc8, 0x6f, 0xe5, 0x60, 0xfe, 0xc0, 0x70, 0xbc,
+	0x97, 0x0b, 0x99, 0x62, 0x71, 0xff, 0x64, 0x3c, 0xee, 0x57, 0xeb, 0x80, 0xc2, 0xa3, 0xe2, 0x87,
+	0xde, 0x33, 0xa8, 0x6e, 0x1a, 0x5e, 0xfb, 0xec, 0xd2, 0xc3, 0xfc, 0x26, 0x94, 0x1d, 0x46, 0x10,
+	0x07, 0x85, 0x12, 0x74, 0x09, 0x8b, 0xa6, 0x27, 0x85, 0xcf, 0xab, 0xfe, 0x29, 0x82, 0x5a, 0x9c,
+	0x8c, 0xb6, 0x60, 0x86, 0x25, 0x0f, 0x75, 0xe6, 0x18, 0xb9, 0x1f, 0x5f, 0x8d, 0x7f, 0xb0, 0x10,
+	0xf9, 0xe0, 0x6d, 0x67, 0x42, 0xab, 0x1e, 0x87, 0x9a, 0xd1, 0xb7, 0x01, 0xb8, 0x94, 0x53, 0x1c,
+	0x7c, 0x5d, 0x17, 0x13, 0x11, 0xdc, 0x17, 0xd9, 0x99, 0xd0, 0xa6, 0x8f, 0x45, 0x5b, 0x68, 0x08,
+	0x2c, 0x05, 0xdd, 0xc8, 0xcb, 0x87, 0x10, 0x59, 0xdd, 0x60, 0x08, 0xac, 0x19, 0xfd, 0x02, 0x54,
+	0xb8, 0x14, 0x7a, 0x4d, 0x46, 0x84, 0xe8, 0x92, 0x2f, 0x63, 0x02, 0x09, 0x70, 0xec, 0x37, 0xa2,
+	0x0d, 0xa8, 0xf2, 0x8c, 0xe9, 0x31, 0x01, 0xb2, 0xbc, 0xe4, 0xbb, 0x12, 0x2f, 0x5a, 0x84, 0x53,
+	0x35, 0x3b, 0x13, 0x5a, 0xc5, 0x0e, 0x5a, 0xc9, 0x44, 0xb8, 0x88, 0x36, 0x8d, 0xdb, 0x1a, 0x53,
+	0xf1, 0x89, 0x48, 0xee, 0x46, 0x92, 0x89, 0xd8, 0xa1, 0x66, 0xa2, 0x4b, 0x2e, 0xe5, 0x14, 0x8b,
+	0x8d, 0xa3, 0xc4, 0x45, 0x44, 0x75, 0x69, 0x8b, 0x36, 0xa2, 0x05, 0xde, 0x99, 0x6a, 0x61, 0x3a,
+	0xae, 0x85, 0xc4, 0xc5, 0x14, 0xa2, 0x05, 0xdb, 0x6f, 0x44, 0x4f, 0x60, 0x21, 0xac, 0x05, 0xb1,
+	0x22, 0x6c, 0x2f, 0xaa, 0x52, 0x65, 0xc4, 0x97, 0x65, 0xde, 0x8e, 0xd3, 0xd0, 0xc7, 0x50, 0xe7,
+	0x52, 0x4f, 0x28, 0x0c, 0x14, 0x62, 0xd9, 0x35, 0x88, 0x44, 0x75, 0x4b, 0x02, 0xba, 0x77, 0x26,
+	0x34, 0x64, 0x27, 0x88, 0xa8, 0x09, 0xb3, 0x81, 0xae, 0x74, 0x92, 0xee, 0xaf, 0xcb, 0x55, 0x1e,
+	0xa9, 0x5e, 0x04, 0x2a, 0x27, 0xcd, 0x7d, 0x17, 0x7d, 0x06, 0xcb, 0x21, 0xad, 0xe9, 0x7d, 0x76,
+	0x95, 0x50, 0x67, 0x3b, 0xdd, 0x6d, 0x2c, 0x52, 0x99, 0x6f, 0xca, 0xb4, 0x28, 0xbd, 0x48, 0xb9,
+	0x33, 0xa1, 0x35, 0xec, 0x14, 0x16, 0xf4, 0xa1, 0x7f, 0x09, 0xc6, 0xbf, 0x8c, 0xb5, 0x44, 0xe5,
+	0xdf, 0x8e, 0xcb, 0x8f, 0x01, 0x81, 0x9d, 0x09, 0x71, 0x0b, 0x46, 0x10, 0xd0, 0x2f, 0xc1, 0x22,
+	0x97, 0x35, 0xa0, 0x49, 0xeb, 0x20, 0x5f, 0xde, 0xa0, 0x22, 0xef, 0xc4, 0x45, 0x4a, 0xeb, 0x0f,
+	0x3b, 0x13, 0x5a, 0xdd, 0x96, 0x90, 0xd1, 0x1e, 0xcc, 0x47, 0x8c, 0xa1, 0x67, 0x3f, 0xc5, 0x0d,
+	0x45, 0x7e, 0x63, 0x87, 0x2e, 0xf7, 0x63, 0xfb, 0x69, 0x68, 0xc1, 0xe6, 0xec, 0x28, 0x05, 0x7d,
+	0x0f, 0x50, 0xd4, 0x0c, 0xa8, 0xc0, 0xe5, 0xb5, 0x5c, 0xf4, 0x2a, 0x5a, 0xd8, 0x08, 0xa2, 0x12,
+	0x6b, 0x76, 0x8c, 0x94, 0x18, 0x62, 0xdb, 0xee, 0x5f, 0x34, 0x56, 0x32, 0x86, 0xf8, 0xd0, 0xee,
+	0x5f, 0xc8, 0x87, 0x48, 0x28, 0xc9, 0x21, 0x52, 0x81, 0xab, 0x59, 0x43, 0x8c, 0x4a, 0xac, 0xd9,
+	0x31, 0x12, 0xa9, 0x01, 0x8a, 0x2f, 0x10, 0xb9, 0xd9, 0xdf, 0xa2, 0xe2, 0x6e, 0xa5, 0xd6, 0x54,
+	0x85, 0xac, 0x19, 0x3b, 0xdc, 0x8e, 0xbe, 0x0f, 0x37, 0xf8, 0xd8, 0xfa, 0xa4, 0xc2, 0x17, 0x14,
+	0x03, 0x6f, 0x53, 0x79, 0xaf, 0xc5, 0xe5, 0xc9, 0x2a, 0xa0, 0x3b, 0x13, 0xda, 0x82, 0x9d, 0xa4,
+	0x12, 0xd9, 0xdc, 0x7b, 0xba, 0xd8, 0xd3, 0x43, 0x1f, 0xc4, 0xad, 0xc5, 0x65, 0xa7, 0x7f, 0x8f,
+	0x47, 0x64, 0x1f, 0x27, 0xa9, 0xc4, 0x2d, 0x0a, 0x50, 0xc3, 0x5c, 0x6b, 0x35, 0xe5, 0x0a, 0x63,
+	0xcc, 0xb7, 0x56, 0xdd, 0x50, 0x33, 0x51, 0x63, 0x50, 0xbc, 0xa3, 0xde, 0x75, 0x26, 0xae, 0x46,
+	0x59, 0x76, 0x95, 0xa8, 0xd1, 0x0d, 0xb7, 0x13, 0x17, 0x27, 0x04, 0xf5, 0x8c, 0x73, 0xcc, 0xc1,
+	0x5d, 0x63, 0x36, 0xee, 0xe2, 0xd2, 0x72, 0x67, 0xc4, 0xc5, 0xb9, 0x71, 0x1a, 0x71, 0x71, 0x91,
+	0x49, 0x8a, 0xb5, 0x9e, 0x8b, 0xbb, 0xb8, 0xd4, 0x14, 0x0f, 0x71, 0x71, 0x6e, 0x82, 0x48, 0x56,
+	0x46, 0x08, 0x8e, 0x3a, 0xcf, 0x5a, 0x7c, 0x65, 0xd2, 0x53, 0x16, 0x64, 0x65, 0xdc, 0x24, 0x95,
+	0x9c, 0x79, 0x91, 0xbb, 0xa5, 0xf3, 0xf1, 0x33, 0x2f, 0x19, 0x9c, 0x93, 0x33, 0x2f, 0x7c, 0xb9,
+	0xf4, 0xb1, 0xe4, 0x72, 0x29, 0x8a, 0xef, 0x3f, 0x79, 0x64, 0x43, 0xf6, 0x5f, 0xec, 0x76, 0x29,
+	0x39, 0xbf, 0x28, 0xa6, 0xe2, 0x73, 0xbc, 0x19, 0x3f, 0xbf, 0x12, 0x28, 0x8f, 0x9c, 0x5f, 0x7d,
+	0xbf, 0x91, 0x1c, 0x08, 0x0e, 0x7e, 0x6a, 0x9f, 0x63, 0x5d, 0xfc, 0x11, 0xc6, 0x42, 0xdc, 0xd8,
+	0x34, 0x4a, 0xdf, 0x38, 0x68, 0x11, 0xc8, 0x1f, 0x18, 0x1b, 0xeb, 0xb6, 0x41, 0xff, 0x2f, 0x63,
+	0x73, 0x1a, 0xa6, 0x38, 0x49, 0xfd, 0x10, 0x66, 0x38, 0x68, 0xf2, 0xef, 0x66, 0x4c, 0x3b, 0xfc,
+	0xb7, 0xc0, 0x5f, 0xcb, 0x09, 0xfc, 0x15, 0xba, 0x97, 0x11, 0x70, 0xab, 0x7f, 0x8f, 0x60, 0x3e,
+	0xc1, 0x80, 0x9a, 0x72, 0x08, 0x76, 0x2b, 0x0d, 0x82, 0xb1, 0xae, 0x09, 0x0c, 0xf6, 0x9e, 0x04,
+	0x83, 0x2d, 0x4b, 0x31, 0x98, 0x2f, 0x20, 0x04, 0xc2, 0x9a, 0x72, 0x10, 0x76, 0x2b, 0x0d, 0x84,
+	0xc5, 0x07, 0xc1, 0xf5, 0xff, 0xbe, 0x0c, 0x85, 0xad, 0xc8, 0x51, 0x98, 0x2f, 0x22, 0x0c, 0xc3,
+	0x36, 0xa5, 0x30, 0x6c, 0x35, 0x05, 0x86, 0xf9, 0x22, 0x22, 0x38, 0xac, 0x29, 0xc7, 0x61, 0xb7,
+	0xd2, 0x70, 0x58, 0x30, 0x97, 0x08, 0x10, 0x7b, 0x4f, 0x02, 0xc4, 0x96, 0xa5, 0x40, 0x2c, 0x50,
+	0x68, 0x80, 0xc4, 0xde, 0x97, 0x21, 0xb1, 0x15, 0x39, 0x12, 0x0b, 0x34, 0x11, 0x82, 0x62, 0x47,
+	0x59, 0x50, 0xec, 0xd5, 0x4c, 0x28, 0xe6, 0xcb, 0x93, 0x60, 0xb1, 0x4f, 0x32, 0xb1, 0xd8, 0x6b,
+	0xd9, 0x58, 0xcc, 0x17, 0x2c, 0x03, 0x63, 0x1f, 0xa4, 0x80, 0xb1, 0x5b, 0x69, 0x60, 0x2c, 0xae,
+	0x77, 0x8e, 0xc6, 0xce, 0x47, 0x41, 0x63, 0x6f, 0x8d, 0x82, 0xc6, 0xfc, 0x17, 0xa4, 0xc3, 0xb1,
+	0x47, 0x69, 0x70, 0x6c, 0x2d, 0x1d, 0x8e, 0xf9, 0x62, 0xe3, 0x78, 0xec, 0x97, 0x87, 0xe0, 0xb1,
+	0xd7, 0x87, 0xe1, 0x31, 0x5f, 0xb2, 0x1c, 0x90, 0xed, 0xa7, 0x03, 0xb2, 0x57, 0x32, 0x00, 0x99,
+	0x2f, 0x35, 0x81, 0xc8, 0xb4, 0x0c, 0x44, 0xa6, 0x66, 0x21, 0x32, 0x5f, 0x64, 0x12, 0x92, 0xed,
+	0xa7, 0x43, 0xb2, 0x57, 0x32, 0x20, 0x99, 0x74, 0x90, 0x84, 0x94, 0x1c, 0x64, 0x08, 0x93, 0xa9,
+	0x59, 0x98, 0x4c, 0x3e, 0x48, 0x2a, 0x73, 0x27, 0x05, 0x94, 0xdd, 0x1e, 0x72, 0xd1, 0x2d, 0x89,
+	0xca, 0x3e, 0xcd, 0x46, 0x65, 0x77, 0x86, 0xa0, 0x32, 0x5f, 0xac, 0x14, 0x96, 0x7d, 0x9a, 0x0d,
+	0xcb, 0xee, 0x0c, 0x81, 0x65, 0x81, 0x70, 0x19, 0x2e, 0x6b, 0xca, 0x71, 0xd9, 0xad, 0x34, 0x5c,
+	0x16, 0x6c, 0xd7, 0x08, 0x30, 0xdb, 0x49, 0x01, 0x66, 0xb7, 0x53, 0x81, 0x59, 0xa0, 0xca, 0x28,
+	0x32, 0x3b, 0xca, 0x42, 0x66, 0xaf, 0x66, 0x22, 0xb3, 0xc0, 0xe3, 0x25, 0xa1, 0xd9, 0x27, 0x99,
+	0xd0, 0xec, 0xb5, 0x6c, 0x68, 0x16, 0x78, 0x3c, 0x09, 0x36, 0xfb, 0x34, 0x1b, 0x9b, 0xdd, 0x19,
+	0x82, 0xcd, 0x82, 0xe5, 0x91, 0x81, 0xb3, 0x4d, 0x29, 0x38, 0xcb, 0xfe, 0xf0, 0x27, 0x8e, 0xce,
+	0xf6, 0x52, 0xd1, 0xd9, 0xf0, 0x4f, 0x7f, 0x64, 0xf0, 0xec, 0x7d, 0x19, 0x3c, 0x5b, 0x91, 0xc3,
+	0xb3, 0xe0, 0x50, 0x0b, 0xe1, 0xb3, 0x0f, 0x52, 0xf0, 0xd9, 0xad, 0x34, 0x7c, 0x16, 0x18, 0x5d,
+	0x04, 0xa0, 0x01, 0x94, 0x05, 0x4d, 0xd5, 0x61, 0x41, 0x82, 0xe9, 0xc6, 0xcf, 0xab, 0xa5, 0xfd,
+	0x7b, 0x1a, 0xf9, 0xa6, 0x52, 0x36, 0x28, 0x72, 0x91, 0x7c, 0x51, 0x1e, 0xfd, 0x7e, 0x91, 0x57,
+	0xfa, 0x56, 0x01, 0x2c, 0xfc, 0x4c, 0xe7, 0xd2, 0xf8, 0x1f, 0x61, 0x59, 0xf8, 0x19, 0xff, 0xf3,
+	0xb6, 0xff, 0x0f, 0x0d, 0x42, 0x96, 0x0a, 0x65, 0xb9, 0xed, 0x1b, 0x16, 0x7e, 0xd6, 0x4c, 0xc8,
+	0x55, 0xff, 0x75, 0x12, 0x96, 0x52, 0x8e, 0x96, 0x71, 0x33, 0xa7, 0x7b, 0xb0, 0x22, 0xb9, 0xb4,
+	0x37, 0xe4, 0x5e, 0xca, 0xcd, 0xc4, 0xfd, 0x3d, 0x3f, 0xa9, 0xfd, 0x0e, 0x2c, 0xca, 0xe5, 0xf1,
+	0xe9, 0xd7, 0x65, 0x5d, 0xc3, 0xd1, 0xcf, 0x39, 0xbe, 0x20, 0x77, 0x6a, 0xf3, 0x51, 0x4b, 0x0c,
+	0xdf, 0x0f, 0xdc, 0xb0, 0x3a, 0x6c, 0x18, 0x62, 0x7f, 0x3d, 0xc2, 0x17, 0x6e, 0x7a, 0xad, 0xad,
+	0x78, 0xa5, 0x5a, 0xdb, 0x1f, 0xe7, 0x85, 0xaa, 0x13, 0x59, 0x90, 0x17, 0x9e, 0xd5, 0x8e, 0x9a,
+	0x4f, 0x69, 0x1c, 0xf3, 0x99, 0xcc, 0x30, 0x1f, 0x74, 0x04, 0x6b, 0xd1, 0x8e, 0x92, 0x75, 0x97,
+	0xde, 0xf3, 0x58, 0x09, 0xcb, 0x4b, 0x2c, 0xfd, 0xb7, 0x41, 0x49, 0x17, 0xcb, 0x0d, 0x7a, 0x29,
+	0x45, 0x02, 0x29, 0x34, 0x91, 0xce, 0x11, 0x2b, 0x28, 0x8e, 0x64, 0x05, 0xb3, 0x16, 0x7e, 0x76,
+	0x18, 0x18, 0x82, 0xaa, 0x40, 0x23, 0xb9, 0x60, 0x72, 0x37, 0x11, 0xca, 0x17, 0xfd, 0x2f, 0x70,
+	0x13, 0x61, 0x24, 0xf6, 0x7f, 0x6e, 0xe2, 0x7a, 0xdd, 0xc4, 0x6f, 0x16, 0xa2, 0x6e, 0xe2, 0x4a,
+	0x96, 0x75, 0x25, 0x37, 0x31, 0x39, 0x8e, 0xf9, 0xe4, 0xb3, 0xdc, 0xc4, 0xd7, 0x60, 0xde, 0xff,
+	0x13, 0x87, 0xc8, 0xf7, 0x69, 0x65, 0xad, 0x26, 0x08, 0x7e, 0x3c, 0xf4, 0x0e, 0x2c, 0xca, 0x37,
+	0x3f, 0xaf, 0x6a, 0xd6, 0x65, 0x1b, 0x7f, 0x24, 0x4f, 0x54, 0xb8, 0x6e, 0x4f, 0x54, 0x1c, 0xdf,
+	0x13, 0x95, 0x2e, 0xe5, 0x89, 0xb6, 0xa0, 0x91, 0xb4, 0x89, 0xb1, 0x3f, 0x62, 0xfe, 0x49, 0x0e,
+	0xea, 0xb2, 0xd7, 0x5d, 0xf6, 0xca, 0xc7, 0x4b, 0xb8, 0x80, 0xfa, 0xd6, 0xb7, 0x00, 0x42, 0xd1,
+	0xcd, 0x1c, 0x54, 0x8e, 0xf6, 0x3e, 0x6a, 0x6a, 0x87, 0xad, 0xfd, 0xbd, 0x26, 0xff, 0x9c, 0xa8,
+	0xb9, 0xb7, 0xb1, 0xb9, 0x2b, 0x3e, 0x27, 0x3a, 0x3c, 0x3a, 0x3c, 0x68, 0xee, 0x6d, 0x35, 0xb7,
+	0x6a, 0x93, 0x0f, 0x7e, 0x7e, 0x03, 0xca, 0x8f, 0xf9, 0x2c, 0xd0, 0x63, 0xa8, 0xb2, 0x94, 0x1a,
+	0xb7, 0xe5, 0xec, 0x5a, 0xa8, 0x32, 0x24, 0x4f, 0x87, 0xb6, 0x60, 0x7a, 0x1b, 0x7b, 0x5c, 0x56,
+	0x46, 0x51, 0x54, 0xc9, 0x4a, 0xd6, 0x91, 0x41, 0x31, 0x08, 0x9d, 0x36, 0xa8, 0x48, 0x56, 0x54,
+	0x19, 0x92, 0xb7, 0x43, 0x3b, 0x50, 0x21, 0x01, 0x02, 0xa3, 0xb9, 0x28, 0xab, 0x4e, 0xaa, 0x64,
+	0xa6, 0xef, 0xd0, 0x31, 0xb9, 0xca, 0xc4, 0x05, 0x85, 0xd4, 0x3f, 0x52, 0xc5, 0x40, 0x19, 0x2d,
+	0x80, 0x45, 0x1f, 0x42, 0x85, 0x1e, 0x26, 0xfc, 0x0f, 0xe2, 0x32, 0x8b, 0xb2, 0x4a, 0x76, 0xae,
+	0x90, 0xae, 0x2e, 0x0d, 0x37, 0xb9, 0xb0, 0xec, 0xea, 0xac, 0x32, 0x24, 0x69, 0xc8, 0x57, 0x97,
+	0xcb, 0xca, 0x28, 0xd3, 0x2a, 0x59, 0x99, 0x43, 0xb1, 0x1c, 0x8c, 0x10, 0x59, 0x8e, 0x44, 0xc1,
+	0x56, 0xc9, 0xcc, 0x21, 0xa2, 0x1f, 0xc0, 0x7c, 0x28, 0x42, 0xe5, 0xe3, 0x1a, 0xa1, 0x70, 0xab,
+	0x8c, 0x92, 0x51, 0x44, 0x3a, 0xa0, 0x70, 0x8c, 0xca, 0xc5, 0x8f, 0x52, 0xc0, 0x55, 0x46, 0xca,
+	0x2c, 0xa2, 0x03, 0x98, 0x09, 0x8b, 0x76, 0xd1, 0x90, 0x2a, 0x99, 0x32, 0x2c, 0x61, 0x43, 0xec,
+	0x93, 0xe6, 0x54, 0x18, 0xd5, 0xcf, 0xac, 0x8c, 0x54, 0x2d, 0x53, 0x46, 0xcb, 0xde, 0x10, 0x9b,
+	0xf2, 0x8d, 0xa0, 0x75, 0xe0, 0xa2, 0xec, 0xf2, 0xb3, 0x32, 0x24, 0x21, 0x8a, 0x7e, 0x08, 0x8d,
+	0x50, 0xa6, 0x92, 0xb1, 0x88, 0x7c, 0xe5, 0xe8, 0x55, 0x68, 0x65, 0x8c, 0x14, 0x29, 0x3a, 0x84,
+	0x59, 0x11, 0xe4, 0xf3, 0x45, 0x1d, 0x56, 0x8e, 0x56, 0x86, 0x26, 0x48, 0x11, 0x86, 0x3a, 0x4b,
+	0x60, 0x32, 0xba, 0x7f, 0x00, 0x8f, 0x56, 0x96, 0x56, 0x46, 0xcc, 0x96, 0x12, 0xed, 0x53, 0x5b,
+	0x15, 0xdf, 0x50, 0x65, 0x17, 0x16, 0x95, 0x21, 0xf9, 0x2d, 0x62, 0x82, 0x6c, 0x8f, 0x0b, 0x79,
+	0x43, 0x2a, 0x8c, 0xca, 0xb0, 0x44, 0x17, 0xd9, 0x93, 0x41, 0x3a, 0x4a, 0x48, 0x1d, 0xa1, 0xd2,
+	0xa8, 0x8c, 0x92, 0xf3, 0x22, 0x7b, 0x32, 0xb4, 0x55, 0x85, 0xf8, 0x51, 0x2a, 0x8e, 0xca, 0x48,
+	0xb9, 0x2f, 0xb2, 0x83, 0xc2, 0x7b, 0x55, 0xbc, 0x61, 0xa4, 0xca, 0xa3, 0x32, 0x5a, 0x0e, 0x0c,
+	0x3d, 0x82, 0x2a, 0xb1, 0x4e, 0xce, 0xe2, 0xa2, 0xcc, 0x1a, 0xa4, 0x92, 0x9d, 0x04, 0x43, 0x87,
+	0x80, 0xc2, 0xc2, 0x98, 0xad, 0x5f, 0x49, 0xe4, 0xfd, 0x1c, 0xfa, 0x08, 0xe6, 0x84, 0x81, 0x0b,
+	0x0d, 0x0c, 0xad, 0x70, 0x2a, 0xc3, 0xb3, 0x6c, 0x68, 0x1b, 0x80, 0xe9, 0x82, 0xe4, 0xce, 0x50,
+	0x56, 0xa9, 0x53, 0xc9, 0x4c, 0xb4, 0xa1, 0x77, 0xa1, 0x48, 0x6b, 0x8b, 0x68, 0x51, 0x7e, 0x1b,
+	0x4c, 0x59, 0x4a, 0xa9, 0x52, 0x92, 0xe3, 0x35, 0xf4, 0xaf, 0xad, 0x61, 0x45, 0x25, 0xff, 0x13,
+	0x56, 0x59, 0x4d, 0xa1, 0x06, 0x9b, 0x31, 0x9c, 0x2b, 0x43, 0xd9, 0x85, 0x57, 0x65, 0x48, 0xde,
+	0x8f, 0x68, 0xdd, 0xcf, 0x36, 0x71, 0xc7, 0x34, 0xf4, 0xea, 0x89, 0x32, 0xbc, 0x16, 0x82, 0x7e,
+	0x11, 0x6a, 0x41, 0xa4, 0xce, 0x05, 0x0f, 0xbf, 0x82, 0xa2, 0x8c, 0x50, 0x13, 0xf1, 0x87, 0x4c,
+	0x90, 0x77, 0xe6, 0x90, 0x43, 0xe1, 0x9a, 0x32, 0xbc, 0x32, 0x12, 0x0c, 0x39, 0x24, 0x78, 0xf8,
+	0x95, 0x14, 0x65, 0x84, 0x0a, 0xc9, 0x66, 0xfd, 0xfb, 0xf4, 0x3f, 0x81, 0x3f, 0x5b, 0x37, 0xed,
+	0x7b, 0x24, 0x87, 0x6f, 0x5b, 0xf7, 0xfa, 0xc7, 0xc7, 0x25, 0x7a, 0x91, 0xfa, 0xff, 0xfd, 0xcf,
+	0x00, 0x5e, 0xe5, 0x02, 0x3d, 0xeb, 0x62, 0x00, 0x00,
 }
@@ -46,12 +46,15 @@ type DRPCMetainfoClient interface {
 	GetBucket(ctx context.Context, in *BucketGetRequest) (*BucketGetResponse, error)
 	DeleteBucket(ctx context.Context, in *BucketDeleteRequest) (*BucketDeleteResponse, error)
 	ListBuckets(ctx context.Context, in *BucketListRequest) (*BucketListResponse, error)
+	SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
 	BeginObject(ctx context.Context, in *ObjectBeginRequest) (*ObjectBeginResponse, error)
 	CommitObject(ctx context.Context, in *ObjectCommitRequest) (*ObjectCommitResponse, error)
 	GetObject(ctx context.Context, in *ObjectGetRequest) (*ObjectGetResponse, error)
 	ListObjects(ctx context.Context, in *ObjectListRequest) (*ObjectListResponse, error)
 	BeginDeleteObject(ctx context.Context, in *ObjectBeginDeleteRequest) (*ObjectBeginDeleteResponse, error)
 	FinishDeleteObject(ctx context.Context, in *ObjectFinishDeleteRequest) (*ObjectFinishDeleteResponse, error)
+	DeleteObjects(ctx context.Context, in *ObjectsDeleteRequest) (*ObjectsDeleteResponse, error)
+	PurgeObjectVersions(ctx context.Context, in *ObjectPurgeVersionsRequest) (*ObjectPurgeVersionsResponse, error)
 	GetObjectIPs(ctx context.Context, in *ObjectGetIPsRequest) (*ObjectGetIPsResponse, error)
 	ListPendingObjectStreams(ctx context.Context, in *ObjectListPendingStreamsRequest) (*ObjectListPendingStreamsResponse, error)
 	DownloadObject(ctx context.Context, in *ObjectDownloadRequest) (*ObjectDownloadResponse, error)
@@ -62,6 +65,7 @@ type DRPCMetainfoClient interface {
 	BeginDeleteSegment(ctx context.Context, in *SegmentBeginDeleteRequest) (*SegmentBeginDeleteResponse, error)
 	FinishDeleteSegment(ctx context.Context, in *SegmentFinishDeleteRequest) (*SegmentFinishDeleteResponse, error)
 	ListSegments(ctx context.Context, in *SegmentListRequest) (*SegmentListResponse, error)
+	ListSegmentsStream(ctx context.Context, in *SegmentListRequest) (DRPCMetainfo_ListSegmentsStreamClient, error)
 	DownloadSegment(ctx context.Context, in *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeletePart(ctx context.Context, in *PartDeleteRequest) (*PartDeleteResponse, error)
 	Batch(ctx context.Context, in *BatchRequest) (*BatchResponse, error)
@@ -119,6 +123,15 @@ func (c *drpcMetainfoClient) ListBuckets(ctx context.Context, in *BucketListRequ
 	return out, nil
 }
 
+func (c *drpcMetainfoClient) SetBucketVersioning(ctx context.Context, in *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error) {
+	out := new(BucketSetVersioningResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketVersioning", drpcEncoding_File_metainfo_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *drpcMetainfoClient) BeginObject(ctx context.Context, in *ObjectBeginRequest) (*ObjectBeginResponse, error) {
 	out := new(ObjectBeginResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/BeginObject", drpcEncoding_File_metainfo_proto{}, in, out)
@@ -173,6 +186,24 @@ func (c *drpcMetainfoClient) FinishDeleteObject(ctx context.Context, in *ObjectF
 	return out, nil
 }
 
+func (c *drpcMetainfoClient) DeleteObjects(ctx context.Context, in *ObjectsDeleteRequest) (*ObjectsDeleteResponse, error) {
+	out := new(ObjectsDeleteResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DeleteObjects", drpcEncoding_File_metainfo_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoClient) PurgeObjectVersions(ctx context.Context, in *ObjectPurgeVersionsRequest) (*ObjectPurgeVersionsResponse, error) {
+	out := new(ObjectPurgeVersionsResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/PurgeObjectVersions", drpcEncoding_File_metainfo_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *drpcMetainfoClient) GetObjectIPs(ctx context.Context, in *ObjectGetIPsRequest) (*ObjectGetIPsResponse, error) {
 	out := new(ObjectGetIPsResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetObjectIPs", drpcEncoding_File_metainfo_proto{}, in, out)
@@ -263,6 +294,42 @@ func (c *drpcMetainfoClient) ListSegments(ctx context.Context, in *SegmentListRe
 	return out, nil
 }
 
+func (c *drpcMetainfoClient) ListSegmentsStream(ctx context.Context, in *SegmentListRequest) (DRPCMetainfo_ListSegmentsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, "/metainfo.Metainfo/ListSegmentsStream", drpcEncoding_File_metainfo_proto{})
+	if err != nil {
+		return nil, err
+	}
+	x := &drpcMetainfo_ListSegmentsStreamClient{stream}
+	if err := x.MsgSend(in, drpcEncoding_File_metainfo_proto{}); err != nil {
+		return nil, err
+	}
+	if err := x.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DRPCMetainfo_ListSegmentsStreamClient interface {
+	drpc.Stream
+	Recv() (*SegmentListResponse, error)
+}
+
+type drpcMetainfo_ListSegmentsStreamClient struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfo_ListSegmentsStreamClient) Recv() (*SegmentListResponse, error) {
+	m := new(SegmentListResponse)
+	if err := x.MsgRecv(m, drpcEncoding_File_metainfo_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcMetainfo_ListSegmentsStreamClient) RecvMsg(m *SegmentListResponse) error {
+	return x.MsgRecv(m, drpcEncoding_File_metainfo_proto{})
+}
+
 func (c *drpcMetainfoClient) DownloadSegment(ctx context.Context, in *SegmentDownloadRequest) (*SegmentDownloadResponse, error) {
 	out := new(SegmentDownloadResponse)
 	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/DownloadSegment", drpcEncoding_File_metainfo_proto{}, in, out)
@@ -349,12 +416,15 @@ type DRPCMetainfoServer interface {
 	GetBucket(context.Context, *BucketGetRequest) (*BucketGetResponse, error)
 	DeleteBucket(context.Context, *BucketDeleteRequest) (*BucketDeleteResponse, error)
 	ListBuckets(context.Context, *BucketListRequest) (*BucketListResponse, error)
+	SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error)
 	BeginObject(context.Context, *ObjectBeginRequest) (*ObjectBeginResponse, error)
 	CommitObject(context.Context, *ObjectCommitRequest) (*ObjectCommitResponse, error)
 	GetObject(context.Context, *ObjectGetRequest) (*ObjectGetResponse, error)
 	ListObjects(context.Context, *ObjectListRequest) (*ObjectListResponse, error)
 	BeginDeleteObject(context.Context, *ObjectBeginDeleteRequest) (*ObjectBeginDeleteResponse, error)
 	FinishDeleteObject(context.Context, *ObjectFinishDeleteRequest) (*ObjectFinishDeleteResponse, error)
+	DeleteObjects(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteResponse, error)
+	PurgeObjectVersions(context.Context, *ObjectPurgeVersionsRequest) (*ObjectPurgeVersionsResponse, error)
 	GetObjectIPs(context.Context, *ObjectGetIPsRequest) (*ObjectGetIPsResponse, error)
 	ListPendingObjectStreams(context.Context, *ObjectListPendingStreamsRequest) (*ObjectListPendingStreamsResponse, error)
 	DownloadObject(context.Context, *ObjectDownloadRequest) (*ObjectDownloadResponse, error)
@@ -365,6 +435,7 @@ type DRPCMetainfoServer interface {
 	BeginDeleteSegment(context.Context, *SegmentBeginDeleteRequest) (*SegmentBeginDeleteResponse, error)
 	FinishDeleteSegment(context.Context, *SegmentFinishDeleteRequest) (*SegmentFinishDeleteResponse, error)
 	ListSegments(context.Context, *SegmentListRequest) (*SegmentListResponse, error)
+	ListSegmentsStream(*SegmentListRequest, DRPCMetainfo_ListSegmentsStreamStream) error
 	DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeletePart(context.Context, *PartDeleteRequest) (*PartDeleteResponse, error)
 	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
@@ -394,6 +465,10 @@ func (s *DRPCMetainfoUnimplementedServer) ListBuckets(context.Context, *BucketLi
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCMetainfoUnimplementedServer) SetBucketVersioning(context.Context, *BucketSetVersioningRequest) (*BucketSetVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 func (s *DRPCMetainfoUnimplementedServer) BeginObject(context.Context, *ObjectBeginRequest) (*ObjectBeginResponse, error) {
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
@@ -418,6 +493,14 @@ func (s *DRPCMetainfoUnimplementedServer) FinishDeleteObject(context.Context, *O
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCMetainfoUnimplementedServer) DeleteObjects(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoUnimplementedServer) PurgeObjectVersions(context.Context, *ObjectPurgeVersionsRequest) (*ObjectPurgeVersionsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 func (s *DRPCMetainfoUnimplementedServer) GetObjectIPs(context.Context, *ObjectGetIPsRequest) (*ObjectGetIPsResponse, error) {
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
@@ -458,6 +541,10 @@ func (s *DRPCMetainfoUnimplementedServer) ListSegments(context.Context, *Segment
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCMetainfoUnimplementedServer) ListSegmentsStream(*SegmentListRequest, DRPCMetainfo_ListSegmentsStreamStream) error {
+	return drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 func (s *DRPCMetainfoUnimplementedServer) DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error) {
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
@@ -496,7 +583,7 @@ func (s *DRPCMetainfoUnimplementedServer) FinishCopyObject(context.Context, *Obj
 
 type DRPCMetainfoDescription struct{}
 
-func (DRPCMetainfoDescription) NumMethods() int { return 29 }
+func (DRPCMetainfoDescription) NumMethods() int { return 33 }
 
 func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -537,6 +624,15 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 					)
 			}, DRPCMetainfoServer.ListBuckets, true
 	case 4:
+		return "/metainfo.Metainfo/SetBucketVersioning", drpcEncoding_File_metainfo_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoServer).
+					SetBucketVersioning(
+						ctx,
+						in1.(*BucketSetVersioningRequest),
+					)
+			}, DRPCMetainfoServer.SetBucketVersioning, true
+	case 5:
 		return "/metainfo.Metainfo/BeginObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -545,7 +641,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectBeginRequest),
 					)
 			}, DRPCMetainfoServer.BeginObject, true
-	case 5:
+	case 6:
 		return "/metainfo.Metainfo/CommitObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -554,7 +650,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectCommitRequest),
 					)
 			}, DRPCMetainfoServer.CommitObject, true
-	case 6:
+	case 7:
 		return "/metainfo.Metainfo/GetObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -563,7 +659,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectGetRequest),
 					)
 			}, DRPCMetainfoServer.GetObject, true
-	case 7:
+	case 8:
 		return "/metainfo.Metainfo/ListObjects", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -572,7 +668,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectListRequest),
 					)
 			}, DRPCMetainfoServer.ListObjects, true
-	case 8:
+	case 9:
 		return "/metainfo.Metainfo/BeginDeleteObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -581,7 +677,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectBeginDeleteRequest),
 					)
 			}, DRPCMetainfoServer.BeginDeleteObject, true
-	case 9:
+	case 10:
 		return "/metainfo.Metainfo/FinishDeleteObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -590,7 +686,25 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectFinishDeleteRequest),
 					)
 			}, DRPCMetainfoServer.FinishDeleteObject, true
-	case 10:
+	case 11:
+		return "/metainfo.Metainfo/DeleteObjects", drpcEncoding_File_metainfo_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoServer).
+					DeleteObjects(
+						ctx,
+						in1.(*ObjectsDeleteRequest),
+					)
+			}, DRPCMetainfoServer.DeleteObjects, true
+	case 12:
+		return "/metainfo.Metainfo/PurgeObjectVersions", drpcEncoding_File_metainfo_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoServer).
+					PurgeObjectVersions(
+						ctx,
+						in1.(*ObjectPurgeVersionsRequest),
+					)
+			}, DRPCMetainfoServer.PurgeObjectVersions, true
+	case 13:
 		return "/metainfo.Metainfo/GetObjectIPs", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -599,7 +713,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectGetIPsRequest),
 					)
 			}, DRPCMetainfoServer.GetObjectIPs, true
-	case 11:
+	case 14:
 		return "/metainfo.Metainfo/ListPendingObjectStreams", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -608,7 +722,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectListPendingStreamsRequest),
 					)
 			}, DRPCMetainfoServer.ListPendingObjectStreams, true
-	case 12:
+	case 15:
 		return "/metainfo.Metainfo/DownloadObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -617,7 +731,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectDownloadRequest),
 					)
 			}, DRPCMetainfoServer.DownloadObject, true
-	case 13:
+	case 16:
 		return "/metainfo.Metainfo/UpdateObjectMetadata", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -626,7 +740,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectUpdateMetadataRequest),
 					)
 			}, DRPCMetainfoServer.UpdateObjectMetadata, true
-	case 14:
+	case 17:
 		return "/metainfo.Metainfo/BeginSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -635,7 +749,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentBeginRequest),
 					)
 			}, DRPCMetainfoServer.BeginSegment, true
-	case 15:
+	case 18:
 		return "/metainfo.Metainfo/CommitSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -644,7 +758,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentCommitRequest),
 					)
 			}, DRPCMetainfoServer.CommitSegment, true
-	case 16:
+	case 19:
 		return "/metainfo.Metainfo/MakeInlineSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -653,7 +767,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentMakeInlineRequest),
 					)
 			}, DRPCMetainfoServer.MakeInlineSegment, true
-	case 17:
+	case 20:
 		return "/metainfo.Metainfo/BeginDeleteSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -662,7 +776,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentBeginDeleteRequest),
 					)
 			}, DRPCMetainfoServer.BeginDeleteSegment, true
-	case 18:
+	case 21:
 		return "/metainfo.Metainfo/FinishDeleteSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -671,7 +785,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentFinishDeleteRequest),
 					)
 			}, DRPCMetainfoServer.FinishDeleteSegment, true
-	case 19:
+	case 22:
 		return "/metainfo.Metainfo/ListSegments", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -680,7 +794,16 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentListRequest),
 					)
 			}, DRPCMetainfoServer.ListSegments, true
-	case 20:
+	case 23:
+		return "/metainfo.Metainfo/ListSegmentsStream", drpcEncoding_File_metainfo_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return nil, srv.(DRPCMetainfoServer).
+					ListSegmentsStream(
+						in1.(*SegmentListRequest),
+						&drpcMetainfo_ListSegmentsStreamStream{in2.(drpc.Stream)},
+					)
+			}, DRPCMetainfoServer.ListSegmentsStream, true
+	case 24:
 		return "/metainfo.Metainfo/DownloadSegment", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -689,7 +812,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*SegmentDownloadRequest),
 					)
 			}, DRPCMetainfoServer.DownloadSegment, true
-	case 21:
+	case 25:
 		return "/metainfo.Metainfo/DeletePart", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -698,7 +821,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*PartDeleteRequest),
 					)
 			}, DRPCMetainfoServer.DeletePart, true
-	case 22:
+	case 26:
 		return "/metainfo.Metainfo/Batch", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -707,7 +830,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*BatchRequest),
 					)
 			}, DRPCMetainfoServer.Batch, true
-	case 23:
+	case 27:
 		return "/metainfo.Metainfo/ProjectInfo", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -716,7 +839,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ProjectInfoRequest),
 					)
 			}, DRPCMetainfoServer.ProjectInfo, true
-	case 24:
+	case 28:
 		return "/metainfo.Metainfo/RevokeAPIKey", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -725,7 +848,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*RevokeAPIKeyRequest),
 					)
 			}, DRPCMetainfoServer.RevokeAPIKey, true
-	case 25:
+	case 29:
 		return "/metainfo.Metainfo/BeginMoveObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -734,7 +857,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectBeginMoveRequest),
 					)
 			}, DRPCMetainfoServer.BeginMoveObject, true
-	case 26:
+	case 30:
 		return "/metainfo.Metainfo/FinishMoveObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -743,7 +866,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectFinishMoveRequest),
 					)
 			}, DRPCMetainfoServer.FinishMoveObject, true
-	case 27:
+	case 31:
 		return "/metainfo.Metainfo/BeginCopyObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -752,7 +875,7 @@ func (DRPCMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiv
 						in1.(*ObjectBeginCopyRequest),
 					)
 			}, DRPCMetainfoServer.BeginCopyObject, true
-	case 28:
+	case 32:
 		return "/metainfo.Metainfo/FinishCopyObject", drpcEncoding_File_metainfo_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCMetainfoServer).
@@ -834,6 +957,22 @@ func (x *drpcMetainfo_ListBucketsStream) SendAndClose(m *BucketListResponse) err
 	return x.CloseSend()
 }
 
+type DRPCMetainfo_SetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*BucketSetVersioningResponse) error
+}
+
+type drpcMetainfo_SetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfo_SetBucketVersioningStream) SendAndClose(m *BucketSetVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCMetainfo_BeginObjectStream interface {
 	drpc.Stream
 	SendAndClose(*ObjectBeginResponse) error
@@ -930,6 +1069,38 @@ func (x *drpcMetainfo_FinishDeleteObjectStream) SendAndClose(m *ObjectFinishDele
 	return x.CloseSend()
 }
 
+type DRPCMetainfo_DeleteObjectsStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectsDeleteResponse) error
+}
+
+type drpcMetainfo_DeleteObjectsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfo_DeleteObjectsStream) SendAndClose(m *ObjectsDeleteResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfo_PurgeObjectVersionsStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectPurgeVersionsResponse) error
+}
+
+type drpcMetainfo_PurgeObjectVersionsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfo_PurgeObjectVersionsStream) SendAndClose(m *ObjectPurgeVersionsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCMetainfo_GetObjectIPsStream interface {
 	drpc.Stream
 	SendAndClose(*ObjectGetIPsResponse) error
@@ -1090,6 +1261,19 @@ func (x *drpcMetainfo_ListSegmentsStream) SendAndClose(m *SegmentListResponse) e
 	return x.CloseSend()
 }
 
+type DRPCMetainfo_ListSegmentsStreamStream interface {
+	drpc.Stream
+	Send(*SegmentListResponse) error
+}
+
+type drpcMetainfo_ListSegmentsStreamStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfo_ListSegmentsStreamStream) Send(m *SegmentListResponse) error {
+	return x.MsgSend(m, drpcEncoding_File_metainfo_proto{})
+}
+
 type DRPCMetainfo_DownloadSegmentStream interface {
 	drpc.Stream
 	SendAndClose(*SegmentDownloadResponse) error
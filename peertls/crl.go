@@ -0,0 +1,270 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package peertls
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/singleflight"
+)
+
+// maxCRLSize is the largest certificate revocation list that will be downloaded.
+const maxCRLSize = 16 << 20
+
+var (
+	// ErrCRL is used when a certificate revocation list can't be fetched or verified.
+	ErrCRL = errs.Class("certificate revocation list")
+	// ErrRevokedCert is used when a certificate is listed in its issuer's revocation list.
+	ErrRevokedCert = errs.Class("certificate revoked")
+)
+
+// SetCRLDistributionPoints sets the CRL distribution points which are embedded
+// in certificates created from template. Only http and https urls are allowed.
+func SetCRLDistributionPoints(template *x509.Certificate, urls ...string) error {
+	for _, rawurl := range urls {
+		u, err := url.Parse(rawurl)
+		if err != nil {
+			return ErrTLSTemplate.Wrap(err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return ErrTLSTemplate.New("unsupported CRL distribution point %q", rawurl)
+		}
+	}
+	template.CRLDistributionPoints = append([]string(nil), urls...)
+	return nil
+}
+
+const (
+	// crlRetryInterval is the minimum time between fetches of a revocation
+	// list which couldn't be fetched or which has expired.
+	crlRetryInterval = time.Minute
+
+	// maxCachedCRLs is the number of revocation lists kept by a CRLCache.
+	maxCachedCRLs = 64
+)
+
+// CRLCache fetches and caches the certificate revocation lists published at
+// the distribution points of certificates. Concurrent requests for the same
+// list share a single download. At most 64 lists are kept, the least recently
+// fetched one is evicted to make room for another.
+type CRLCache struct {
+	client *http.Client
+	maxAge time.Duration
+
+	fetches singleflight.Group
+
+	mu    sync.Mutex
+	lists map[string]*cachedCRL
+}
+
+type cachedCRL struct {
+	// list is the last successfully fetched list, nil if there is none.
+	list    *pkix.CertificateList
+	fetched time.Time
+
+	// attempted is the time of the last fetch and err its result.
+	attempted time.Time
+	err       error
+}
+
+// NewCRLCache returns a cache which downloads revocation lists with client.
+// A list is refetched after its next update time or, when it's older than
+// maxAge, whichever comes first. A zero maxAge only uses the next update time.
+//
+// When refetching fails, the last fetched list is used until its next update
+// time. Lists which can't be fetched, or which have expired, are retried at
+// most once a minute.
+func NewCRLCache(client *http.Client, maxAge time.Duration) *CRLCache {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &CRLCache{
+		client: client,
+		maxAge: maxAge,
+		lists:  map[string]*cachedCRL{},
+	}
+}
+
+// Get returns the revocation list published at location, downloading it when
+// it's not cached or the cached version is stale.
+func (cache *CRLCache) Get(ctx context.Context, location string) (*pkix.CertificateList, error) {
+	now := time.Now()
+
+	cache.mu.Lock()
+	cached, ok := cache.lists[location]
+	cache.mu.Unlock()
+	if ok && !cache.stale(cached, now) {
+		return cached.usable(now)
+	}
+
+	// the download is shared with concurrent callers, so it's bound to the
+	// context of the first one.
+	result, _, _ := cache.fetches.Do(location, func() (interface{}, error) {
+		list, err := cache.fetch(ctx, location)
+
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		updated := &cachedCRL{attempted: time.Now(), err: err}
+		if previous, ok := cache.lists[location]; ok {
+			updated.list, updated.fetched = previous.list, previous.fetched
+		} else if len(cache.lists) >= maxCachedCRLs {
+			cache.evictOldest()
+		}
+		if err == nil {
+			updated.list, updated.fetched = list, updated.attempted
+		}
+		cache.lists[location] = updated
+		return updated, nil
+	})
+	return result.(*cachedCRL).usable(now)
+}
+
+// evictOldest removes the least recently fetched list. It must be called with
+// mu held.
+func (cache *CRLCache) evictOldest() {
+	var oldest string
+	var oldestAttempted time.Time
+	for location, cached := range cache.lists {
+		if oldest == "" || cached.attempted.Before(oldestAttempted) {
+			oldest, oldestAttempted = location, cached.attempted
+		}
+	}
+	delete(cache.lists, oldest)
+}
+
+// stale returns whether the cached list should be fetched again.
+func (cache *CRLCache) stale(cached *cachedCRL, now time.Time) bool {
+	if cached.err != nil || cached.list.HasExpired(now) {
+		return now.Sub(cached.attempted) >= crlRetryInterval
+	}
+	return cache.maxAge > 0 && now.Sub(cached.fetched) > cache.maxAge
+}
+
+// usable returns the list which should be used for verification. A list is
+// kept until its next update time, even when refetching it failed.
+func (cached *cachedCRL) usable(now time.Time) (*pkix.CertificateList, error) {
+	if cached.list != nil && (cached.err == nil || !cached.list.HasExpired(now)) {
+		return cached.list, nil
+	}
+	return nil, cached.err
+}
+
+func (cache *CRLCache) fetch(ctx context.Context, location string) (_ *pkix.CertificateList, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, ErrCRL.Wrap(err)
+	}
+	resp, err := cache.client.Do(req)
+	if err != nil {
+		return nil, ErrCRL.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrCRL.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrCRL.New("unexpected status %q from %q", resp.Status, location)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCRLSize+1))
+	if err != nil {
+		return nil, ErrCRL.Wrap(err)
+	}
+	if len(data) > maxCRLSize {
+		return nil, ErrCRL.New("%q is larger than %d bytes", location, maxCRLSize)
+	}
+
+	list, err := x509.ParseCRL(data)
+	if err != nil {
+		return nil, ErrCRL.Wrap(err)
+	}
+	return list, nil
+}
+
+// Check verifies that cert, issued by issuer, isn't revoked by any of the
+// revocation lists at its distribution points. Certificates without
+// distribution points are not checked. The issuer must be trusted, as the
+// lists are fetched from the locations in cert and verified with issuer.
+func (cache *CRLCache) Check(ctx context.Context, cert, issuer *x509.Certificate) error {
+	if len(cert.CRLDistributionPoints) == 0 {
+		return nil
+	}
+
+	var group errs.Group
+	for _, location := range cert.CRLDistributionPoints {
+		list, err := cache.Get(ctx, location)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		if err := issuer.CheckCRLSignature(list); err != nil {
+			group.Add(ErrCRL.New("invalid signature on %q: %v", location, err))
+			continue
+		}
+		if list.HasExpired(time.Now()) {
+			group.Add(ErrCRL.New("%q has expired", location))
+			continue
+		}
+
+		for _, revoked := range list.TBSCertList.RevokedCertificates {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return ErrRevokedCert.New("serial number %s", cert.SerialNumber)
+			}
+		}
+		// the certificate is verified as soon as a single list is usable.
+		return nil
+	}
+	return group.Err()
+}
+
+// VerifyCRLFunc returns a peer certificate verification function which checks
+// the certificates in the chain that are signed by one of the trusted issuers
+// against the revocation lists of their distribution points. Other
+// certificates aren't checked, since their distribution points and lists are
+// controlled by the peer itself. Fetching the lists during a handshake takes
+// at most timeout, unless it's zero.
+//
+// When failOpen is true, certificates are accepted if their revocation lists
+// can't be fetched or verified, so that an outage of a distribution point
+// doesn't reject every peer. Revoked certificates are always rejected.
+func VerifyCRLFunc(cache *CRLCache, issuers []*x509.Certificate, timeout time.Duration, failOpen bool) PeerCertVerificationFunc {
+	return func(_ [][]byte, parsedChains [][]*x509.Certificate) error {
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel func()
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		for _, cert := range parsedChains[0] {
+			issuer := trustedIssuer(issuers, cert)
+			if issuer == nil {
+				continue
+			}
+			err := cache.Check(ctx, cert, issuer)
+			if err != nil && !(failOpen && ErrCRL.Has(err)) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// trustedIssuer returns the issuer which signed cert, or nil if none did.
+func trustedIssuer(issuers []*x509.Certificate, cert *x509.Certificate) *x509.Certificate {
+	for _, issuer := range issuers {
+		if verifyCertSignature(issuer, cert) == nil {
+			return issuer
+		}
+	}
+	return nil
+}
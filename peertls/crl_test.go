@@ -0,0 +1,304 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package peertls_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/peertls"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/testcontext"
+)
+
+func TestSetCRLDistributionPoints(t *testing.T) {
+	template, err := peertls.LeafTemplate()
+	require.NoError(t, err)
+
+	require.NoError(t, peertls.SetCRLDistributionPoints(template, "http://example.test/ca.crl"))
+	require.Equal(t, []string{"http://example.test/ca.crl"}, template.CRLDistributionPoints)
+
+	err = peertls.SetCRLDistributionPoints(template, "ldap://example.test/ca.crl")
+	require.True(t, peertls.ErrTLSTemplate.Has(err))
+}
+
+func TestVerifyCRLFunc(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	caKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	caTemplate, err := peertls.CATemplate()
+	require.NoError(t, err)
+	caTemplate.KeyUsage |= x509.KeyUsageCRLSign
+	caCert, err := peertls.CreateSelfSignedCertificate(caKey, caTemplate)
+	require.NoError(t, err)
+
+	var revoked []pkix.RevokedCertificate
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			RevokedCertificates: revoked,
+			Number:              big.NewInt(atomic.LoadInt64(&requests)),
+			ThisUpdate:          time.Now().Add(-time.Minute),
+			NextUpdate:          time.Now().Add(time.Hour),
+		}, caCert, caKey.(crypto.Signer))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(crl)
+	}))
+	defer server.Close()
+
+	newLeaf := func() *x509.Certificate {
+		leafKey, err := pkcrypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		leafTemplate, err := peertls.LeafTemplate()
+		require.NoError(t, err)
+		require.NoError(t, peertls.SetCRLDistributionPoints(leafTemplate, server.URL))
+		pubKey, err := pkcrypto.PublicKeyFromPrivate(leafKey)
+		require.NoError(t, err)
+		leafCert, err := peertls.CreateCertificate(pubKey, caKey, leafTemplate, caCert)
+		require.NoError(t, err)
+		return leafCert
+	}
+
+	good, bad := newLeaf(), newLeaf()
+	revoked = append(revoked, pkix.RevokedCertificate{
+		SerialNumber:   bad.SerialNumber,
+		RevocationTime: time.Now(),
+	})
+
+	cache := peertls.NewCRLCache(server.Client(), time.Hour)
+	verify := peertls.VerifyCRLFunc(cache, []*x509.Certificate{caCert}, time.Minute, false)
+
+	require.NoError(t, verify(nil, [][]*x509.Certificate{{good, caCert}}))
+
+	err = verify(nil, [][]*x509.Certificate{{bad, caCert}})
+	require.Error(t, err)
+	require.True(t, peertls.ErrRevokedCert.Has(err))
+
+	// the list is cached.
+	require.EqualValues(t, 1, atomic.LoadInt64(&requests))
+
+	// a list which isn't signed by the issuer is rejected.
+	otherKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherTemplate, err := peertls.CATemplate()
+	require.NoError(t, err)
+	otherCA, err := peertls.CreateSelfSignedCertificate(otherKey, otherTemplate)
+	require.NoError(t, err)
+
+	err = cache.Check(ctx, good, otherCA)
+	require.Error(t, err)
+	require.True(t, peertls.ErrCRL.Has(err))
+
+	// certificates of untrusted issuers aren't checked, their lists aren't
+	// even fetched.
+	atomic.StoreInt64(&requests, 0)
+	untrusted := peertls.VerifyCRLFunc(peertls.NewCRLCache(server.Client(), time.Hour), []*x509.Certificate{otherCA}, time.Minute, false)
+	require.NoError(t, untrusted(nil, [][]*x509.Certificate{{bad, caCert}}))
+	require.Zero(t, atomic.LoadInt64(&requests))
+}
+
+func TestVerifyCRLFunc_SelfSigned(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	trustedKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	trustedTemplate, err := peertls.CATemplate()
+	require.NoError(t, err)
+	trusted, err := peertls.CreateSelfSignedCertificate(trustedKey, trustedTemplate)
+	require.NoError(t, err)
+
+	// a peer with its own CA, which points to a location of its choosing.
+	peerKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	peerTemplate, err := peertls.CATemplate()
+	require.NoError(t, err)
+	require.NoError(t, peertls.SetCRLDistributionPoints(peerTemplate, server.URL))
+	peerCA, err := peertls.CreateSelfSignedCertificate(peerKey, peerTemplate)
+	require.NoError(t, err)
+
+	leafTemplate, err := peertls.LeafTemplate()
+	require.NoError(t, err)
+	require.NoError(t, peertls.SetCRLDistributionPoints(leafTemplate, server.URL))
+	leafKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey, err := pkcrypto.PublicKeyFromPrivate(leafKey)
+	require.NoError(t, err)
+	leaf, err := peertls.CreateCertificate(pubKey, peerKey, leafTemplate, peerCA)
+	require.NoError(t, err)
+
+	verify := peertls.VerifyCRLFunc(peertls.NewCRLCache(server.Client(), 0), []*x509.Certificate{trusted}, time.Minute, false)
+	require.NoError(t, verify(nil, [][]*x509.Certificate{{leaf, peerCA}}))
+	require.Zero(t, atomic.LoadInt64(&requests))
+}
+
+func TestCRLCache_Size(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	cache := peertls.NewCRLCache(server.Client(), 0)
+	for i := 0; i <= 64; i++ {
+		_, _ = cache.Get(ctx, fmt.Sprintf("%s/%d", server.URL, i))
+	}
+	require.EqualValues(t, 65, atomic.LoadInt64(&requests))
+
+	// the first list has been evicted, the last one is still cached.
+	_, _ = cache.Get(ctx, fmt.Sprintf("%s/%d", server.URL, 64))
+	require.EqualValues(t, 65, atomic.LoadInt64(&requests))
+	_, _ = cache.Get(ctx, server.URL+"/0")
+	require.EqualValues(t, 66, atomic.LoadInt64(&requests))
+}
+
+func TestCRLCache_Unavailable(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cache := peertls.NewCRLCache(server.Client(), 0)
+	_, err := cache.Get(ctx, server.URL)
+	require.Error(t, err)
+	require.True(t, peertls.ErrCRL.Has(err))
+}
+
+func TestCRLCache_TooLarge(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(make([]byte, 16<<20+1))
+	}))
+	defer server.Close()
+
+	cache := peertls.NewCRLCache(server.Client(), 0)
+	_, err := cache.Get(ctx, server.URL)
+	require.Error(t, err)
+	require.True(t, peertls.ErrCRL.Has(err))
+	require.Contains(t, err.Error(), "larger than")
+}
+
+func TestCRLCache_Refetch(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	caKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	caTemplate, err := peertls.CATemplate()
+	require.NoError(t, err)
+	caTemplate.KeyUsage |= x509.KeyUsageCRLSign
+	caCert, err := peertls.CreateSelfSignedCertificate(caKey, caTemplate)
+	require.NoError(t, err)
+
+	var requests, failing int64
+	nextUpdate := time.Now().Add(time.Hour)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		<-release
+		if atomic.LoadInt64(&failing) != 0 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+			Number:     big.NewInt(1),
+			ThisUpdate: nextUpdate.Add(-2 * time.Hour),
+			NextUpdate: nextUpdate,
+		}, caCert, caKey.(crypto.Signer))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(crl)
+	}))
+	defer server.Close()
+
+	t.Run("concurrent fetches are shared", func(t *testing.T) {
+		atomic.StoreInt64(&requests, 0)
+		cache := peertls.NewCRLCache(server.Client(), 0)
+
+		const n = 10
+		var group errgroup.Group
+		for i := 0; i < n; i++ {
+			group.Go(func() error {
+				_, err := cache.Get(ctx, server.URL)
+				return err
+			})
+		}
+		// give the callers time to wait for the same download.
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		require.NoError(t, group.Wait())
+		require.EqualValues(t, 1, atomic.LoadInt64(&requests))
+	})
+
+	t.Run("last good list is kept", func(t *testing.T) {
+		atomic.StoreInt64(&requests, 0)
+		cache := peertls.NewCRLCache(server.Client(), time.Nanosecond)
+
+		good, err := cache.Get(ctx, server.URL)
+		require.NoError(t, err)
+
+		atomic.StoreInt64(&failing, 1)
+		defer atomic.StoreInt64(&failing, 0)
+
+		list, err := cache.Get(ctx, server.URL)
+		require.NoError(t, err)
+		require.Equal(t, good, list)
+		require.EqualValues(t, 2, atomic.LoadInt64(&requests))
+
+		// the failed fetch isn't retried immediately.
+		_, err = cache.Get(ctx, server.URL)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, atomic.LoadInt64(&requests))
+	})
+
+	t.Run("expired list", func(t *testing.T) {
+		atomic.StoreInt64(&requests, 0)
+		nextUpdate = time.Now().Add(-time.Hour)
+		cache := peertls.NewCRLCache(server.Client(), 0)
+
+		leafKey, err := pkcrypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		leafTemplate, err := peertls.LeafTemplate()
+		require.NoError(t, err)
+		require.NoError(t, peertls.SetCRLDistributionPoints(leafTemplate, server.URL))
+		pubKey, err := pkcrypto.PublicKeyFromPrivate(leafKey)
+		require.NoError(t, err)
+		leaf, err := peertls.CreateCertificate(pubKey, caKey, leafTemplate, caCert)
+		require.NoError(t, err)
+
+		err = peertls.VerifyCRLFunc(cache, []*x509.Certificate{caCert}, time.Minute, false)(nil, [][]*x509.Certificate{{leaf, caCert}})
+		require.Error(t, err)
+		require.True(t, peertls.ErrCRL.Has(err))
+
+		// the expired list is cached and the peer is accepted when failing open.
+		require.NoError(t, peertls.VerifyCRLFunc(cache, []*x509.Certificate{caCert}, time.Minute, true)(nil, [][]*x509.Certificate{{leaf, caCert}}))
+		require.EqualValues(t, 1, atomic.LoadInt64(&requests))
+	})
+}
@@ -4,6 +4,10 @@
 package tlsopts
 
 import (
+	"crypto/tls"
+	"strings"
+	"time"
+
 	"storj.io/common/peertls/extensions"
 )
 
@@ -14,4 +18,64 @@ type Config struct {
 	UsePeerCAWhitelist  bool   `devDefault:"false" releaseDefault:"true" help:"if true, uses peer ca whitelist checking"`
 	PeerIDVersions      string `default:"latest" help:"identity version(s) the server will be allowed to talk to"`
 	Extensions          extensions.Config
+	CRLCheck            bool          `default:"false" help:"if true, verifies peer certificates signed by a trusted CRL issuer against the CRL distribution points they embed"`
+	CRLIssuersPath      string        `help:"path to the certificates of the trusted CRL issuers, the peer CA whitelist is used when empty"`
+	CRLMaxAge           time.Duration `default:"1h" help:"how long a fetched certificate revocation list is used before refetching it"`
+	CRLFetchTimeout     time.Duration `default:"10s" help:"maximum time spent fetching certificate revocation lists during a handshake"`
+	CRLFailClosed       bool          `default:"false" help:"if true, rejects peers whose certificate revocation lists can't be fetched or verified, otherwise they are accepted"`
+	MinVersion          string        `default:"1.2" help:"minimum TLS version to negotiate (1.2 or 1.3)"`
+	CipherSuites        string        `default:"" help:"comma separated list of allowed TLS 1.2 ECDSA cipher suites (e.g. TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256), the Go defaults are used when empty"`
+}
+
+// ParseTLSVersion parses a TLS version such as "1.2" or "1.3".
+// Versions older than 1.2 are not supported.
+func ParseTLSVersion(s string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "tls") {
+	case "1.2", "", "12":
+		return tls.VersionTLS12, nil
+	case "1.3", "13":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, Error.New("unsupported TLS version %q", s)
+	}
+}
+
+// ParseCipherSuites parses a comma separated list of cipher suite names.
+// Only the TLS 1.2 suites considered secure by crypto/tls which use ECDSA
+// authentication are accepted, since identities don't have RSA keys.
+// An empty list results in nil, which means the Go defaults.
+func ParseCipherSuites(s string) ([]uint16, error) {
+	secure := map[string]*tls.CipherSuite{}
+	for _, suite := range tls.CipherSuites() {
+		secure[suite.Name] = suite
+	}
+
+	var ids []uint16
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		suite, ok := secure[name]
+		if !ok {
+			return nil, Error.New("unsupported or insecure cipher suite %q", name)
+		}
+		if !supportsTLS12(suite) {
+			return nil, Error.New("cipher suite %q can't be used with TLS 1.2", name)
+		}
+		if !strings.HasPrefix(name, "TLS_ECDHE_ECDSA_") {
+			return nil, Error.New("cipher suite %q doesn't use ECDSA authentication, which identities require", name)
+		}
+		ids = append(ids, suite.ID)
+	}
+	return ids, nil
+}
+
+func supportsTLS12(suite *tls.CipherSuite) bool {
+	for _, version := range suite.SupportedVersions {
+		if version == tls.VersionTLS12 {
+			return true
+		}
+	}
+	return false
 }
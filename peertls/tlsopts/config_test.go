@@ -0,0 +1,186 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tlsopts_test
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+)
+
+func TestParseTLSVersion(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		version uint16
+	}{
+		{"", tls.VersionTLS12},
+		{"1.2", tls.VersionTLS12},
+		{"TLS1.3", tls.VersionTLS13},
+		{"1.3", tls.VersionTLS13},
+	} {
+		version, err := tlsopts.ParseTLSVersion(tt.in)
+		require.NoError(t, err, tt.in)
+		require.Equal(t, tt.version, version, tt.in)
+	}
+
+	_, err := tlsopts.ParseTLSVersion("1.1")
+	require.Error(t, err)
+}
+
+func TestParseCipherSuites(t *testing.T) {
+	suites, err := tlsopts.ParseCipherSuites("")
+	require.NoError(t, err)
+	require.Nil(t, suites)
+
+	suites, err = tlsopts.ParseCipherSuites("TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256")
+	require.NoError(t, err)
+	require.Equal(t, []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	}, suites)
+
+	_, err = tlsopts.ParseCipherSuites("TLS_RSA_WITH_RC4_128_SHA")
+	require.Error(t, err)
+
+	// TLS 1.3 suites aren't configurable.
+	_, err = tlsopts.ParseCipherSuites("TLS_AES_128_GCM_SHA256")
+	require.Error(t, err)
+
+	// identities can't be used with RSA suites.
+	_, err = tlsopts.ParseCipherSuites("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256")
+	require.Error(t, err)
+
+	_, err = tlsopts.ParseCipherSuites("unknown")
+	require.Error(t, err)
+}
+
+func TestNewOptions_TLSPolicy(t *testing.T) {
+	ident, err := testidentity.PregeneratedIdentity(0, storj.LatestIDVersion())
+	require.NoError(t, err)
+
+	opts, err := tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+		CipherSuites:   "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	}, nil)
+	require.NoError(t, err)
+
+	config := opts.ServerTLSConfig()
+	require.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+	require.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, config.CipherSuites)
+	require.Equal(t, tls.RequireAnyClientCert, config.ClientAuth)
+
+	opts, err = tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+		MinVersion:     "1.3",
+	}, nil)
+	require.NoError(t, err)
+
+	config = opts.ServerTLSConfig()
+	require.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	require.Equal(t, tls.RequireAnyClientCert, config.ClientAuth)
+
+	config = opts.OptionalCertServerTLSConfig()
+	require.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+	require.Equal(t, tls.RequestClientCert, config.ClientAuth)
+
+	_, err = tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+		MinVersion:     "1.3",
+		CipherSuites:   "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	}, nil)
+	require.Error(t, err)
+
+	_, err = tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+		MinVersion:     "1.0",
+	}, nil)
+	require.Error(t, err)
+
+	for _, suites := range []string{
+		"TLS_AES_128_GCM_SHA256",
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	} {
+		_, err = tlsopts.NewOptions(ident, tlsopts.Config{
+			PeerIDVersions: "*",
+			CipherSuites:   suites,
+		}, nil)
+		require.Error(t, err, suites)
+	}
+}
+
+func TestOptionalCertServerTLSConfig(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ident, err := testidentity.PregeneratedIdentity(0, storj.LatestIDVersion())
+	require.NoError(t, err)
+
+	opts, err := tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+	}, nil)
+	require.NoError(t, err)
+
+	handshake := func(config *tls.Config, clientCerts ...tls.Certificate) error {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer func() { _ = listener.Close() }()
+
+		ctx.Go(func() error {
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			if err != nil {
+				return err
+			}
+			defer func() { _ = conn.Close() }()
+
+			// the result is checked on the server side.
+			_ = tls.Client(conn, &tls.Config{
+				InsecureSkipVerify: true, // #nosec G402 -- the server identity isn't relevant here.
+				MinVersion:         tls.VersionTLS12,
+				Certificates:       clientCerts,
+			}).HandshakeContext(ctx)
+			return nil
+		})
+
+		conn, err := listener.Accept()
+		require.NoError(t, err)
+		defer func() { _ = conn.Close() }()
+
+		return tls.Server(conn, config).HandshakeContext(ctx)
+	}
+
+	require.Error(t, handshake(opts.ServerTLSConfig()))
+	require.NoError(t, handshake(opts.OptionalCertServerTLSConfig()))
+
+	// certificates which are presented are still verified.
+	invalid := tls.Certificate{
+		Certificate: [][]byte{ident.Leaf.Raw},
+		PrivateKey:  ident.Key,
+	}
+	require.Error(t, handshake(opts.OptionalCertServerTLSConfig(), invalid))
+}
+
+func TestNewOptions_CRLCheck(t *testing.T) {
+	ident, err := testidentity.PregeneratedIdentity(0, storj.LatestIDVersion())
+	require.NoError(t, err)
+
+	// revocation lists are only checked for trusted issuers.
+	_, err = tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions: "*",
+		CRLCheck:       true,
+	}, nil)
+	require.Error(t, err)
+
+	_, err = tlsopts.NewOptions(ident, tlsopts.Config{
+		PeerIDVersions:     "*",
+		CRLCheck:           true,
+		UsePeerCAWhitelist: true,
+	}, nil)
+	require.NoError(t, err)
+}
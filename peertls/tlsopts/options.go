@@ -32,6 +32,9 @@ type Options struct {
 	PeerCAWhitelist   []*x509.Certificate
 	VerificationFuncs *VerificationFuncs
 	Cert              *tls.Certificate
+
+	minVersion   uint16
+	cipherSuites []uint16
 }
 
 // VerificationFuncs keeps track of of client and server peer certificate verification
@@ -86,6 +89,18 @@ func (opts *Options) ExtensionOptions() *extensions.Options {
 // configure adds peer certificate verification functions and data structures
 // required for completing TLS handshakes to the options.
 func (opts *Options) configure() (err error) {
+	opts.minVersion, err = ParseTLSVersion(opts.Config.MinVersion)
+	if err != nil {
+		return err
+	}
+	opts.cipherSuites, err = ParseCipherSuites(opts.Config.CipherSuites)
+	if err != nil {
+		return err
+	}
+	if len(opts.cipherSuites) > 0 && opts.minVersion == tls.VersionTLS13 {
+		return Error.New("cipher suites can't be configured when the minimum TLS version is 1.3")
+	}
+
 	if opts.Config.UsePeerCAWhitelist {
 		whitelist := []byte(DefaultPeerCAWhitelist)
 		if opts.Config.PeerCAWhitelistPath != "" {
@@ -101,6 +116,28 @@ func (opts *Options) configure() (err error) {
 		opts.VerificationFuncs.ClientAdd(peertls.VerifyCAWhitelist(opts.PeerCAWhitelist))
 	}
 
+	if opts.Config.CRLCheck {
+		// only lists of trusted issuers are fetched, otherwise peers could
+		// make us send requests to arbitrary locations.
+		issuers := opts.PeerCAWhitelist
+		if opts.Config.CRLIssuersPath != "" {
+			issuersPEM, err := ioutil.ReadFile(opts.Config.CRLIssuersPath)
+			if err != nil {
+				return Error.New("unable to find CRL issuers file %v: %v", opts.Config.CRLIssuersPath, err)
+			}
+			issuers, err = pkcrypto.CertsFromPEM(issuersPEM)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		if len(issuers) == 0 {
+			return Error.New("checking revocation lists requires CRL issuers or the peer CA whitelist")
+		}
+
+		cache := peertls.NewCRLCache(nil, opts.Config.CRLMaxAge)
+		opts.VerificationFuncs.Add(peertls.VerifyCRLFunc(cache, issuers, opts.Config.CRLFetchTimeout, !opts.Config.CRLFailClosed))
+	}
+
 	handlers := make(extensions.HandlerFactories, len(extensions.DefaultHandlers))
 	copy(handlers, extensions.DefaultHandlers)
 
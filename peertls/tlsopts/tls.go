@@ -20,6 +20,25 @@ func (opts *Options) ServerTLSConfig() *tls.Config {
 	return opts.tlsConfig(true)
 }
 
+// OptionalCertServerTLSConfig returns a TLSConfig for use as a server, which
+// also accepts clients without a certificate. Clients which present one are
+// verified like with ServerTLSConfig. It must only be used for listeners
+// which serve endpoints that are safe to use without a peer identity, or
+// which check the peer identity themselves.
+func (opts *Options) OptionalCertServerTLSConfig() *tls.Config {
+	config := opts.tlsConfig(true)
+	config.ClientAuth = tls.RequestClientCert
+
+	verify := config.VerifyPeerCertificate
+	config.VerifyPeerCertificate = func(rawCerts [][]byte, chains [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return nil
+		}
+		return verify(rawCerts, chains)
+	}
+	return config
+}
+
 // ClientTLSConfig returns a TSLConfig for use as a client in handshaking with a peer.
 func (opts *Options) ClientTLSConfig(id storj.NodeID) *tls.Config {
 	return opts.tlsConfig(false, verifyIdentity(id))
@@ -53,13 +72,19 @@ func (opts *Options) tlsConfig(isServer bool, verificationFuncs ...peertls.PeerC
 		)
 	}
 
+	minVersion := opts.minVersion
+	if minVersion == 0 {
+		minVersion = tls.VersionTLS12
+	}
+
 	/* #nosec G402 */ // We don't use trusted root certificates, since storage
 	// nodes might not have a CA signed certificate. We use node id-s for the
 	// verification instead, that's why we enable InsecureSkipVerify
 	config := &tls.Config{
 		Certificates:                []tls.Certificate{*opts.Cert},
 		InsecureSkipVerify:          true,
-		MinVersion:                  tls.VersionTLS12,
+		MinVersion:                  minVersion,
+		CipherSuites:                opts.cipherSuites,
 		DynamicRecordSizingDisabled: true, // always start with big records
 		VerifyPeerCertificate: peertls.VerifyPeerFunc(
 			verificationFuncs...,
@@ -0,0 +1,70 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pkcrypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"strings"
+)
+
+// KeyType is the algorithm of a key pair used for identities and signing.
+type KeyType string
+
+const (
+	// KeyTypeECDSA is an ECDSA key pair on the P-256 curve.
+	KeyTypeECDSA = KeyType("ecdsa")
+	// KeyTypeEd25519 is an Ed25519 key pair.
+	KeyTypeEd25519 = KeyType("ed25519")
+)
+
+// ParseKeyType parses a key type name, the empty name is parsed as KeyTypeECDSA.
+func ParseKeyType(s string) (KeyType, error) {
+	switch keyType := KeyType(strings.ToLower(s)); keyType {
+	case "":
+		return KeyTypeECDSA, nil
+	case KeyTypeECDSA, KeyTypeEd25519:
+		return keyType, nil
+	}
+	return "", ErrUnsupportedKey.New("%q", s)
+}
+
+// KeyTypeOf returns the key type of a private or public key.
+func KeyTypeOf(key interface{}) (KeyType, error) {
+	switch key.(type) {
+	case *ecdsa.PrivateKey, *ecdsa.PublicKey:
+		return KeyTypeECDSA, nil
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return KeyTypeEd25519, nil
+	}
+	return "", ErrUnsupportedKey.New("%T", key)
+}
+
+// GeneratePrivateKey returns a new private key of the key type.
+func (keyType KeyType) GeneratePrivateKey() (crypto.PrivateKey, error) {
+	switch keyType {
+	case "", KeyTypeECDSA:
+		return GeneratePrivateECDSAKey(authECCurve)
+	case KeyTypeEd25519:
+		return GeneratePrivateEd25519Key()
+	}
+	return nil, ErrUnsupportedKey.New("%q", string(keyType))
+}
+
+// String returns the name of the key type.
+func (keyType KeyType) String() string { return string(keyType) }
+
+// Set implements flag.Value interface.
+func (keyType *KeyType) Set(s string) error {
+	parsed, err := ParseKeyType(s)
+	if err != nil {
+		return err
+	}
+	*keyType = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (KeyType) Type() string { return "pkcrypto.KeyType" }
@@ -0,0 +1,72 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pkcrypto_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pkcrypto"
+)
+
+func TestKeyType(t *testing.T) {
+	for _, s := range []string{"", "ecdsa", "ECDSA"} {
+		keyType, err := pkcrypto.ParseKeyType(s)
+		require.NoError(t, err)
+		require.Equal(t, pkcrypto.KeyTypeECDSA, keyType)
+	}
+
+	var keyType pkcrypto.KeyType
+	require.NoError(t, keyType.Set("ed25519"))
+	require.Equal(t, pkcrypto.KeyTypeEd25519, keyType)
+	require.Equal(t, "ed25519", keyType.String())
+
+	_, err := pkcrypto.ParseKeyType("rsa")
+	require.True(t, pkcrypto.ErrUnsupportedKey.Has(err))
+	require.Error(t, keyType.Set("rsa"))
+	require.Equal(t, pkcrypto.KeyTypeEd25519, keyType)
+
+	_, err = pkcrypto.KeyTypeOf("invalid")
+	require.True(t, pkcrypto.ErrUnsupportedKey.Has(err))
+}
+
+func TestKeyType_GeneratePrivateKey(t *testing.T) {
+	for _, keyType := range []pkcrypto.KeyType{pkcrypto.KeyTypeECDSA, pkcrypto.KeyTypeEd25519} {
+		keyType := keyType
+		t.Run(keyType.String(), func(t *testing.T) {
+			privKey, err := keyType.GeneratePrivateKey()
+			require.NoError(t, err)
+
+			privType, err := pkcrypto.KeyTypeOf(privKey)
+			require.NoError(t, err)
+			require.Equal(t, keyType, privType)
+
+			pubKey, err := pkcrypto.PublicKeyFromPrivate(privKey)
+			require.NoError(t, err)
+			pubType, err := pkcrypto.KeyTypeOf(pubKey)
+			require.NoError(t, err)
+			require.Equal(t, keyType, pubType)
+
+			// keys must round-trip through PEM
+			var buf bytes.Buffer
+			require.NoError(t, pkcrypto.WritePrivateKeyPEM(&buf, privKey))
+			parsed, err := pkcrypto.PrivateKeyFromPEM(buf.Bytes())
+			require.NoError(t, err)
+			parsedPub, err := pkcrypto.PublicKeyFromPrivate(parsed)
+			require.NoError(t, err)
+			require.True(t, pkcrypto.PublicKeyEqual(pubKey, parsedPub))
+
+			pubPEM, err := pkcrypto.PublicKeyToPEM(pubKey)
+			require.NoError(t, err)
+			parsedPub, err = pkcrypto.PublicKeyFromPEM(pubPEM)
+			require.NoError(t, err)
+			require.True(t, pkcrypto.PublicKeyEqual(pubKey, parsedPub))
+		})
+	}
+
+	_, err := pkcrypto.KeyType("rsa").GeneratePrivateKey()
+	require.True(t, pkcrypto.ErrUnsupportedKey.Has(err))
+}
@@ -6,6 +6,7 @@ package pkcrypto
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/hmac"
 	"crypto/rand"
@@ -52,10 +53,22 @@ func GeneratePrivateRSAKey(bits int) (*rsa.PrivateKey, error) {
 	return rsa.GenerateKey(rand.Reader, bits)
 }
 
+// GeneratePrivateEd25519Key returns a new private Ed25519 key for signing messages.
+func GeneratePrivateEd25519Key() (ed25519.PrivateKey, error) {
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	return privKey, err
+}
+
 // HashAndVerifySignature checks that signature was made by the private key
 // corresponding to the given public key, over a SHA-256 digest of the given
 // data. It returns an error if verification fails, or nil otherwise.
+//
+// Ed25519 signatures are verified over the data itself, because Ed25519
+// hashes the message as part of signing.
 func HashAndVerifySignature(key crypto.PublicKey, data, signature []byte) error {
+	if key, ok := key.(ed25519.PublicKey); ok {
+		return verifyEd25519Signature(key, data, signature)
+	}
 	digest := SHA256Hash(data)
 	return VerifySignatureWithoutHashing(key, digest, signature)
 }
@@ -63,6 +76,9 @@ func HashAndVerifySignature(key crypto.PublicKey, data, signature []byte) error
 // VerifySignatureWithoutHashing checks the signature against the passed data
 // (which is normally a digest) and public key. It returns an error if
 // verification fails, or nil otherwise.
+//
+// Ed25519 keys are not supported, because Ed25519 signatures are made over
+// the data rather than a digest of it. Use HashAndVerifySignature instead.
 func VerifySignatureWithoutHashing(pubKey crypto.PublicKey, digest, signature []byte) error {
 	switch key := pubKey.(type) {
 	case *ecdsa.PublicKey:
@@ -92,6 +108,16 @@ func verifyRSASignatureWithoutHashing(pubKey *rsa.PublicKey, digest, signatureBy
 	return nil
 }
 
+func verifyEd25519Signature(pubKey ed25519.PublicKey, message, signatureBytes []byte) error {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return ErrVerifySignature.New("invalid ed25519 public key size %d", len(pubKey))
+	}
+	if !ed25519.Verify(pubKey, message, signatureBytes) {
+		return ErrVerifySignature.New("signature is not valid")
+	}
+	return nil
+}
+
 // PublicKeyFromPrivate returns the public key corresponding to a given private
 // key.
 // It returns an error if the key isn't of an accepted implementation.
@@ -101,12 +127,17 @@ func PublicKeyFromPrivate(privKey crypto.PrivateKey) (crypto.PublicKey, error) {
 		return key.Public(), nil
 	case *rsa.PrivateKey:
 		return key.Public(), nil
+	case ed25519.PrivateKey:
+		return key.Public(), nil
 	}
 	return nil, ErrUnsupportedKey.New("%T", privKey)
 }
 
 // SignWithoutHashing signs the given digest with the private key and returns
 // the new signature.
+//
+// Ed25519 keys are not supported, because Ed25519 signatures are made over
+// the data rather than a digest of it. Use HashAndSign instead.
 func SignWithoutHashing(privKey crypto.PrivateKey, digest []byte) ([]byte, error) {
 	switch key := privKey.(type) {
 	case *ecdsa.PrivateKey:
@@ -129,6 +160,8 @@ func SignHMACSHA256(privKey crypto.PrivateKey, data []byte) ([]byte, error) {
 		}
 	case *rsa.PrivateKey:
 		secret = x509.MarshalPKCS1PrivateKey(key)
+	case ed25519.PrivateKey:
+		secret = key.Seed()
 	default:
 		return nil, ErrUnsupportedKey.New("%T", privKey)
 	}
@@ -164,9 +197,22 @@ func signRSAWithoutHashing(privKey *rsa.PrivateKey, digest []byte) ([]byte, erro
 	return privKey.Sign(rand.Reader, digest, &pssParams)
 }
 
+func signEd25519(privKey ed25519.PrivateKey, message []byte) ([]byte, error) {
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, ErrSign.New("invalid ed25519 private key size %d", len(privKey))
+	}
+	return ed25519.Sign(privKey, message), nil
+}
+
 // HashAndSign signs a SHA-256 digest of the given data and returns the new
 // signature.
+//
+// Ed25519 keys sign the data itself, because Ed25519 hashes the message as
+// part of signing.
 func HashAndSign(key crypto.PrivateKey, data []byte) ([]byte, error) {
+	if key, ok := key.(ed25519.PrivateKey); ok {
+		return signEd25519(key, data)
+	}
 	digest := SHA256Hash(data)
 	signature, err := SignWithoutHashing(key, digest)
 	if err != nil {
@@ -190,6 +236,12 @@ func PublicKeyEqual(a, b crypto.PublicKey) bool {
 			return false
 		}
 		return publicRSAKeyEqual(aConcrete, bConcrete)
+	case ed25519.PublicKey:
+		bConcrete, ok := b.(ed25519.PublicKey)
+		if !ok {
+			return false
+		}
+		return aConcrete.Equal(bConcrete)
 	}
 	// a best-effort here is probably better than adding an err return
 	return reflect.DeepEqual(a, b)
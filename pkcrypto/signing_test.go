@@ -5,8 +5,6 @@ package pkcrypto
 
 import (
 	"crypto"
-	"crypto/ed25519"
-	"crypto/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,6 +82,45 @@ func TestSigningAndVerifyingRSA(t *testing.T) {
 	}
 }
 
+func TestSigningAndVerifyingEd25519(t *testing.T) {
+	privKey, err := GeneratePrivateEd25519Key()
+	require.NoError(t, err)
+	pubKey, err := PublicKeyFromPrivate(privKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"single byte", "C"},
+		{"longnulls", string(make([]byte, 2000))},
+	}
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			// test signing and verifying a hash of the data
+			sig, err := HashAndSign(privKey, []byte(test.data))
+			require.NoError(t, err)
+			err = HashAndVerifySignature(pubKey, []byte(test.data), sig)
+			assert.NoError(t, err)
+			err = HashAndVerifySignature(pubKey, []byte(test.data+"a"), sig)
+			assert.True(t, ErrVerifySignature.Has(err))
+
+			// Ed25519 signatures are deterministic
+			sig2, err := HashAndSign(privKey, []byte(test.data))
+			require.NoError(t, err)
+			assert.Equal(t, sig, sig2)
+
+			// Ed25519 can't sign or verify precomputed digests
+			_, err = SignWithoutHashing(privKey, SHA256Hash([]byte(test.data)))
+			assert.True(t, ErrUnsupportedKey.Has(err))
+			err = VerifySignatureWithoutHashing(pubKey, SHA256Hash([]byte(test.data)), sig)
+			assert.True(t, ErrUnsupportedKey.Has(err))
+		})
+	}
+}
+
 func TestSigningAndVerifyingHMACSHA256(t *testing.T) {
 	tests := []struct {
 		name string
@@ -100,6 +137,9 @@ func TestSigningAndVerifyingHMACSHA256(t *testing.T) {
 	rsaKey, err := GeneratePrivateRSAKey(StorjRSAKeyBits)
 	require.NoError(t, err)
 
+	ed25519Key, err := GeneratePrivateEd25519Key()
+	require.NoError(t, err)
+
 	for _, tt := range tests {
 		test := tt
 		testFunc := func(t *testing.T, privKey crypto.PrivateKey) {
@@ -125,6 +165,10 @@ func TestSigningAndVerifyingHMACSHA256(t *testing.T) {
 			testFunc(t, rsaKey)
 		})
 
+		t.Run("Ed25519: "+test.name, func(t *testing.T) {
+			testFunc(t, ed25519Key)
+		})
+
 		t.Run("nil key", func(t *testing.T) {
 			_, err = SignHMACSHA256(nil, []byte(test.data))
 			assert.True(t, ErrUnsupportedKey.Has(err), "invalid error class")
@@ -133,8 +177,7 @@ func TestSigningAndVerifyingHMACSHA256(t *testing.T) {
 		})
 
 		t.Run("invalid key type", func(t *testing.T) {
-			_, privKey, err := ed25519.GenerateKey(rand.Reader)
-			require.NoError(t, err)
+			privKey := "invalid"
 			_, err = SignHMACSHA256(privKey, []byte(test.data))
 			assert.True(t, ErrUnsupportedKey.Has(err), "invalid error class")
 			err = VerifyHMACSHA256(privKey, []byte(test.data), nil)
@@ -162,6 +205,16 @@ func TestPublicKeyFromPrivate(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Ed25519", func(t *testing.T) {
+		privKey, err := GeneratePrivateEd25519Key()
+		require.NoError(t, err)
+
+		pubKey, err := PublicKeyFromPrivate(privKey)
+		require.NotNil(t, pubKey, "public key cannot be nil")
+		require.NoError(t, err)
+		require.True(t, PublicKeyEqual(privKey.Public(), pubKey))
+	})
+
 	t.Run("invalid key", func(t *testing.T) {
 		_, err := PublicKeyFromPrivate("invalid")
 		require.Error(t, err)
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/experiment"
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcretry"
 	"storj.io/common/rpc/rpctracing"
 	"storj.io/common/storj"
 	"storj.io/drpc"
@@ -63,6 +64,11 @@ type Dialer struct {
 
 	// Connector is how sockets are opened. If nil, net.Dialer is used.
 	Connector Connector
+
+	// Retry, when not nil, retries failed dials. The rpcs which it declares
+	// idempotent are only retried when Pool is set, because retrying needs a
+	// new connection and only pooled connections redial after a failure.
+	Retry *rpcretry.Policy
 }
 
 // NewDefaultDialer returns a Dialer with default options set.
@@ -200,7 +206,17 @@ func (d Dialer) dialPool(ctx context.Context, key string, dialer rpcpool.Dialer)
 		defer cancel()
 	}
 
-	conn, state, err := d.Pool.Get(ctx, key, d.TLSOptions, rpcpool.WrapDialer(ctx, dialer))
+	var conn drpc.Conn
+	var state *tls.ConnectionState
+	get := func(ctx context.Context) (err error) {
+		conn, state, err = d.Pool.Get(ctx, key, d.TLSOptions, rpcpool.WrapDialer(ctx, dialer))
+		return err
+	}
+	if d.Retry != nil {
+		err = d.Retry.Do(ctx, get)
+	} else {
+		err = get(ctx)
+	}
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
@@ -214,6 +230,11 @@ func (d Dialer) dialPool(ctx context.Context, key string, dialer rpcpool.Dialer)
 		state = &tls.ConnectionState{}
 	}
 
+	// without a pool every retry would use the same broken connection.
+	if d.Retry != nil && d.Pool != nil {
+		conn = rpcretry.NewConn(conn, *d.Retry)
+	}
+
 	return &Conn{
 		state: *state,
 		Conn:  experiment.NewConnWrapper(rpctracing.NewTracingWrapper(conn)),
@@ -0,0 +1,238 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rpcretry implements retrying of dials and idempotent rpcs with
+// exponential backoff.
+package rpcretry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+var mon = monkit.Package()
+
+// Policy controls how operations are retried.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the delay between attempts. If zero, it's unlimited.
+	MaxBackoff time.Duration
+	// Multiplier is the growth factor of the delay between attempts.
+	// Values less than 1 are treated as 1.
+	Multiplier float64
+	// Jitter randomizes every delay by up to this fraction, in both directions.
+	Jitter float64
+
+	// Budget, when not nil, limits the retries across all operations
+	// using the policy.
+	Budget *Budget
+
+	// Idempotent reports whether the rpc can be safely retried after it was
+	// sent to the server. Rpcs are only retried when it returns true.
+	// Dials are always retried.
+	Idempotent func(rpc string) bool
+
+	// Retryable reports whether the error is transient. If nil,
+	// IsRetryable is used.
+	Retryable func(err error) bool
+}
+
+// NewDefaultPolicy returns a policy which makes at most three attempts and
+// treats the rpcs accepted by idempotent as safe to retry.
+func NewDefaultPolicy(idempotent func(rpc string) bool) Policy {
+	return Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Budget:         NewBudget(10, 0.1),
+		Idempotent:     idempotent,
+	}
+}
+
+// IdempotentRPCs returns a function for Policy.Idempotent which accepts the
+// listed rpcs, e.g. "/node.Contact/PingNode".
+func IdempotentRPCs(rpcs ...string) func(rpc string) bool {
+	set := make(map[string]struct{}, len(rpcs))
+	for _, rpc := range rpcs {
+		set[rpc] = struct{}{}
+	}
+	return func(rpc string) bool {
+		_, ok := set[rpc]
+		return ok
+	}
+}
+
+// IsRetryable returns true for errors which are likely to be transient: the
+// Unavailable status code and network errors.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch rpcstatus.Code(err) {
+	case rpcstatus.Unavailable:
+		return true
+	case rpcstatus.Canceled, rpcstatus.DeadlineExceeded:
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Do calls fn until it succeeds, fails with an error which isn't retryable,
+// the attempts or the budget are exhausted, or ctx is canceled.
+func (policy Policy) Do(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil {
+			policy.Budget.success()
+			return nil
+		}
+		if attempt >= policy.MaxAttempts || !retryable(err) || ctx.Err() != nil {
+			return err
+		}
+		if !policy.Budget.withdraw() {
+			mon.Event("retry_budget_exhausted")
+			return err
+		}
+		mon.Event("retry")
+
+		if !sleep(ctx, policy.jitter(backoff)) {
+			return err
+		}
+		backoff = policy.next(backoff)
+	}
+}
+
+// next returns the delay which follows backoff.
+func (policy Policy) next(backoff time.Duration) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	backoff = time.Duration(float64(backoff) * multiplier)
+	if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	return backoff
+}
+
+// jitter randomizes backoff by the configured fraction.
+func (policy Policy) jitter(backoff time.Duration) time.Duration {
+	if policy.Jitter <= 0 || backoff <= 0 {
+		return backoff
+	}
+	delta := (rand.Float64()*2 - 1) * policy.Jitter * float64(backoff) // #nosec G404 -- no need for secure randomness.
+	return backoff + time.Duration(delta)
+}
+
+// sleep waits for the duration and returns false if ctx was canceled first.
+func sleep(ctx context.Context, duration time.Duration) bool {
+	if duration <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Budget limits the ratio of retries to successful operations. Every retry
+// withdraws a token and every success deposits a fraction of one, up to a
+// maximum.
+type Budget struct {
+	mu     sync.Mutex
+	tokens float64
+	max    float64
+	ratio  float64
+}
+
+// NewBudget returns a budget which starts with, and is capped at, max tokens
+// and regains ratio tokens for every success.
+func NewBudget(max int, ratio float64) *Budget {
+	return &Budget{
+		tokens: float64(max),
+		max:    float64(max),
+		ratio:  ratio,
+	}
+}
+
+// withdraw takes a token for a retry. It returns false if there are none.
+// A nil budget always allows retries.
+func (budget *Budget) withdraw() bool {
+	if budget == nil {
+		return true
+	}
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	if budget.tokens < 1 {
+		return false
+	}
+	budget.tokens--
+	return true
+}
+
+// success deposits tokens for a successful operation.
+func (budget *Budget) success() {
+	if budget == nil {
+		return
+	}
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	budget.tokens += budget.ratio
+	if budget.tokens > budget.max {
+		budget.tokens = budget.max
+	}
+}
+
+// Conn wraps a drpc.Conn retrying the unary rpcs which the policy declares
+// idempotent. Retries use the same conn, so it must be able to establish a new
+// connection after a failure, e.g. a connection from rpcpool.
+type Conn struct {
+	drpc.Conn
+	policy Policy
+}
+
+// NewConn returns a Conn which retries rpcs on conn using policy.
+func NewConn(conn drpc.Conn, policy Policy) *Conn {
+	return &Conn{
+		Conn:   conn,
+		policy: policy,
+	}
+}
+
+// Invoke implements drpc.Conn's Invoke method, retrying idempotent rpcs.
+func (c *Conn) Invoke(ctx context.Context, rpc string, enc drpc.Encoding, in, out drpc.Message) error {
+	if c.policy.Idempotent == nil || !c.policy.Idempotent(rpc) {
+		return c.Conn.Invoke(ctx, rpc, enc, in, out)
+	}
+	return c.policy.Do(ctx, func(ctx context.Context) error {
+		return c.Conn.Invoke(ctx, rpc, enc, in, out)
+	})
+}
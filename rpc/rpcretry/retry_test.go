@@ -0,0 +1,148 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpcretry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcretry"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/rpc/rpctest"
+	"storj.io/common/testcontext"
+	"storj.io/drpc"
+)
+
+func TestIsRetryable(t *testing.T) {
+	require.False(t, rpcretry.IsRetryable(nil))
+	require.True(t, rpcretry.IsRetryable(rpcstatus.Error(rpcstatus.Unavailable, "unavailable")))
+	require.False(t, rpcretry.IsRetryable(rpcstatus.Error(rpcstatus.NotFound, "not found")))
+	require.False(t, rpcretry.IsRetryable(context.Canceled))
+	require.False(t, rpcretry.IsRetryable(errors.New("unknown")))
+}
+
+func TestPolicy_Do(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	policy := rpcretry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+	unavailable := rpcstatus.Error(rpcstatus.Unavailable, "unavailable")
+
+	t.Run("success after retries", func(t *testing.T) {
+		attempts := 0
+		err := policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return unavailable
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		attempts := 0
+		err := policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return unavailable
+		})
+		require.Equal(t, unavailable, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		attempts := 0
+		err := policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return rpcstatus.Error(rpcstatus.PermissionDenied, "denied")
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		policy := policy
+		policy.Budget = rpcretry.NewBudget(1, 0.5)
+
+		attempts := 0
+		err := policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return unavailable
+		})
+		require.Error(t, err)
+		require.Equal(t, 2, attempts)
+
+		// the budget has been used up.
+		attempts = 0
+		_ = policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return unavailable
+		})
+		require.Equal(t, 1, attempts)
+
+		// successes refill the budget.
+		require.NoError(t, policy.Do(ctx, func(ctx context.Context) error { return nil }))
+		require.NoError(t, policy.Do(ctx, func(ctx context.Context) error { return nil }))
+
+		attempts = 0
+		_ = policy.Do(ctx, func(ctx context.Context) error {
+			attempts++
+			return unavailable
+		})
+		require.Equal(t, 2, attempts)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		policy := policy
+		policy.InitialBackoff = time.Hour
+
+		cctx, cancel := context.WithCancel(ctx)
+		attempts := 0
+		err := policy.Do(cctx, func(ctx context.Context) error {
+			attempts++
+			cancel()
+			return unavailable
+		})
+		require.Equal(t, unavailable, err)
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestConn(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	calls := map[string]int{}
+	stub := rpctest.NewStubConnection()
+	handler := func(ctx context.Context, rpc string, enc drpc.Encoding, in, out drpc.Message) error {
+		calls[rpc]++
+		if calls[rpc] < 2 {
+			return rpcstatus.Error(rpcstatus.Unavailable, "unavailable")
+		}
+		return nil
+	}
+	stub.RegisterHandler("/service/Get", handler)
+	stub.RegisterHandler("/service/Put", handler)
+
+	policy := rpcretry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Idempotent:     rpcretry.IdempotentRPCs("/service/Get"),
+	}
+	conn := rpcretry.NewConn(&stub, policy)
+
+	require.NoError(t, conn.Invoke(ctx, "/service/Get", nil, nil, nil))
+	require.Equal(t, 2, calls["/service/Get"])
+
+	require.Error(t, conn.Invoke(ctx, "/service/Put", nil, nil, nil))
+	require.Equal(t, 1, calls["/service/Put"])
+}
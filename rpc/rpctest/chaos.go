@@ -0,0 +1,164 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpctest
+
+import (
+	"context"
+	"crypto/tls"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+// ErrChaos is returned for failures injected by Chaos.
+var ErrChaos = errs.Class("chaos")
+
+// Chaos injects latency, dropped messages and partitions into the connections
+// it wraps. The settings can be changed while the connections are in use,
+// which allows a test to degrade and restore a link at will. The random drops
+// are deterministic for a given seed and sequence of messages.
+type Chaos struct {
+	mu          sync.Mutex
+	rng         *rand.Rand
+	latency     time.Duration
+	dropRate    float64
+	partitioned bool
+}
+
+// NewChaos returns a Chaos which doesn't inject anything until configured.
+func NewChaos(seed int64) *Chaos {
+	return &Chaos{
+		rng: rand.New(rand.NewSource(seed)), // #nosec G404 -- deterministic by design.
+	}
+}
+
+// SetLatency sets the delay added before every message and dial.
+func (chaos *Chaos) SetLatency(latency time.Duration) {
+	chaos.mu.Lock()
+	defer chaos.mu.Unlock()
+	chaos.latency = latency
+}
+
+// SetDropRate sets the probability, between 0 and 1, that a message fails and
+// resets its stream.
+func (chaos *Chaos) SetDropRate(rate float64) {
+	chaos.mu.Lock()
+	defer chaos.mu.Unlock()
+	chaos.dropRate = rate
+}
+
+// Partition makes every dial and message fail until Heal is called.
+func (chaos *Chaos) Partition() {
+	chaos.mu.Lock()
+	defer chaos.mu.Unlock()
+	chaos.partitioned = true
+}
+
+// Heal removes a partition.
+func (chaos *Chaos) Heal() {
+	chaos.mu.Lock()
+	defer chaos.mu.Unlock()
+	chaos.partitioned = false
+}
+
+// inject waits for the latency and returns an error if the message should fail.
+func (chaos *Chaos) inject(ctx context.Context) error {
+	chaos.mu.Lock()
+	latency := chaos.latency
+	partitioned := chaos.partitioned
+	dropped := chaos.dropRate > 0 && chaos.rng.Float64() < chaos.dropRate
+	chaos.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	switch {
+	case partitioned:
+		return rpcstatus.Wrap(rpcstatus.Unavailable, ErrChaos.New("partitioned"))
+	case dropped:
+		return rpcstatus.Wrap(rpcstatus.Unavailable, ErrChaos.New("dropped"))
+	}
+	return nil
+}
+
+// Wrap returns a connection which is affected by the chaos.
+func (chaos *Chaos) Wrap(conn drpc.Conn) drpc.Conn {
+	return &chaosConn{Conn: conn, chaos: chaos}
+}
+
+// DialerWrapper returns a wrapper for rpcpool.WithDialerWrapper which makes
+// dials and the dialed connections affected by the chaos.
+func (chaos *Chaos) DialerWrapper() rpcpool.DialerWrapper {
+	return func(ctx context.Context, dialer rpcpool.Dialer) rpcpool.Dialer {
+		return func(ctx context.Context) (drpc.Conn, *tls.ConnectionState, error) {
+			if err := chaos.inject(ctx); err != nil {
+				return nil, nil, err
+			}
+			conn, state, err := dialer(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			return chaos.Wrap(conn), state, nil
+		}
+	}
+}
+
+type chaosConn struct {
+	drpc.Conn
+	chaos *Chaos
+}
+
+// Invoke issues the rpc unless the chaos fails it.
+func (c *chaosConn) Invoke(ctx context.Context, rpc string, enc drpc.Encoding, in, out drpc.Message) error {
+	if err := c.chaos.inject(ctx); err != nil {
+		return err
+	}
+	return c.Conn.Invoke(ctx, rpc, enc, in, out)
+}
+
+// NewStream starts a stream unless the chaos fails it.
+func (c *chaosConn) NewStream(ctx context.Context, rpc string, enc drpc.Encoding) (drpc.Stream, error) {
+	if err := c.chaos.inject(ctx); err != nil {
+		return nil, err
+	}
+	stream, err := c.Conn.NewStream(ctx, rpc, enc)
+	if err != nil {
+		return nil, err
+	}
+	return &chaosStream{Stream: stream, chaos: c.chaos}, nil
+}
+
+type chaosStream struct {
+	drpc.Stream
+	chaos *Chaos
+}
+
+// MsgSend sends the message, or resets the stream if the chaos fails it.
+func (s *chaosStream) MsgSend(msg drpc.Message, enc drpc.Encoding) error {
+	if err := s.chaos.inject(s.Context()); err != nil {
+		return errs.Combine(err, s.Stream.Close())
+	}
+	return s.Stream.MsgSend(msg, enc)
+}
+
+// MsgRecv receives a message, or resets the stream if the chaos fails it.
+func (s *chaosStream) MsgRecv(msg drpc.Message, enc drpc.Encoding) error {
+	if err := s.chaos.inject(s.Context()); err != nil {
+		return errs.Combine(err, s.Stream.Close())
+	}
+	return s.Stream.MsgRecv(msg, enc)
+}
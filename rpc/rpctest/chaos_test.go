@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpctest
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+func TestChaos(t *testing.T) {
+	ctx := context.Background()
+
+	stub := NewStubConnection()
+	stub.RegisterHandler("/test/hello", func(ctx context.Context, rpc string, enc drpc.Encoding, in, out drpc.Message) error {
+		return nil
+	})
+
+	chaos := NewChaos(0)
+	conn := chaos.Wrap(&stub)
+
+	require.NoError(t, conn.Invoke(ctx, "/test/hello", nil, nil, nil))
+
+	chaos.SetLatency(20 * time.Millisecond)
+	start := time.Now()
+	require.NoError(t, conn.Invoke(ctx, "/test/hello", nil, nil, nil))
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	chaos.SetLatency(0)
+
+	chaos.Partition()
+	err := conn.Invoke(ctx, "/test/hello", nil, nil, nil)
+	require.True(t, ErrChaos.Has(err))
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+	chaos.Heal()
+	require.NoError(t, conn.Invoke(ctx, "/test/hello", nil, nil, nil))
+
+	chaos.SetDropRate(0.5)
+	var dropped int
+	for i := 0; i < 100; i++ {
+		if err := conn.Invoke(ctx, "/test/hello", nil, nil, nil); err != nil {
+			require.True(t, ErrChaos.Has(err))
+			dropped++
+		}
+	}
+	require.InDelta(t, 50, dropped, 25)
+
+	// the same seed drops the same messages.
+	replay := NewChaos(0)
+	replay.SetDropRate(0.5)
+	replayConn := replay.Wrap(&stub)
+	chaos = NewChaos(0)
+	chaos.SetDropRate(0.5)
+	conn = chaos.Wrap(&stub)
+	for i := 0; i < 100; i++ {
+		err1 := conn.Invoke(ctx, "/test/hello", nil, nil, nil)
+		err2 := replayConn.Invoke(ctx, "/test/hello", nil, nil, nil)
+		require.Equal(t, err1 == nil, err2 == nil)
+	}
+}
+
+func TestChaos_DialerWrapper(t *testing.T) {
+	ctx := context.Background()
+
+	stub := NewStubConnection()
+	dialer := func(context.Context) (drpc.Conn, *tls.ConnectionState, error) {
+		return &stub, &tls.ConnectionState{}, nil
+	}
+
+	chaos := NewChaos(0)
+	chaos.Partition()
+	_, _, err := chaos.DialerWrapper()(ctx, dialer)(ctx)
+	require.True(t, ErrChaos.Has(err))
+
+	chaos.Heal()
+	conn, _, err := chaos.DialerWrapper()(ctx, dialer)(ctx)
+	require.NoError(t, err)
+	require.IsType(t, &chaosConn{}, conn)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+)
+
+// ExternalKey implements a signer using a crypto.Signer, such as a key kept in
+// a hardware security module or a cloud key management service, so that the
+// private key never has to be loaded into memory.
+type ExternalKey struct {
+	Self   storj.NodeID
+	Signer crypto.Signer
+	// HMACSecret is the secret used for SignHMACSHA256 and VerifyHMACSHA256,
+	// because the private key itself isn't accessible.
+	HMACSecret []byte
+}
+
+// ID returns node id associated with ExternalKey.
+func (external *ExternalKey) ID() storj.NodeID { return external.Self }
+
+// HashAndSign hashes the data and signs it with the external key. The
+// signature has the same format as a signature made by PrivateKey.
+func (external *ExternalKey) HashAndSign(ctx context.Context, data []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var signature []byte
+	switch external.Signer.Public().(type) {
+	case ed25519.PublicKey:
+		signature, err = external.Signer.Sign(rand.Reader, data, crypto.Hash(0))
+	case *rsa.PublicKey:
+		signature, err = external.Signer.Sign(rand.Reader, pkcrypto.SHA256Hash(data), &rsa.PSSOptions{
+			SaltLength: pkcrypto.StorjPSSSaltLength,
+			Hash:       crypto.SHA256,
+		})
+	default:
+		signature, err = external.Signer.Sign(rand.Reader, pkcrypto.SHA256Hash(data), crypto.SHA256)
+	}
+	if err != nil {
+		return nil, pkcrypto.ErrSign.Wrap(err)
+	}
+	return signature, nil
+}
+
+// HashAndVerifySignature hashes the data and verifies that the signature belongs to the external key.
+func (external *ExternalKey) HashAndVerifySignature(ctx context.Context, data, signature []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return pkcrypto.HashAndVerifySignature(external.Signer.Public(), data, signature)
+}
+
+// SignHMACSHA256 signs the given data with HMAC-SHA256 using HMACSecret as the secret.
+func (external *ExternalKey) SignHMACSHA256(ctx context.Context, data []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(external.HMACSecret) == 0 {
+		return nil, pkcrypto.ErrSign.New("missing HMAC secret")
+	}
+
+	mac := hmac.New(sha256.New, external.HMACSecret)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil), nil
+}
+
+// VerifyHMACSHA256 checks that signature matches the HMAC-SHA256 of data using HMACSecret as the secret.
+func (external *ExternalKey) VerifyHMACSHA256(ctx context.Context, data, signature []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	expected, err := external.SignHMACSHA256(ctx, data)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(expected, signature) {
+		return pkcrypto.ErrVerifySignature.New("signature is not valid")
+	}
+	return nil
+}
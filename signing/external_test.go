@@ -0,0 +1,74 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package signing_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+func TestExternalKey(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, key := range []crypto.Signer{ecdsaKey, ed25519Key} {
+		external := &signing.ExternalKey{
+			Self:       testrand.NodeID(),
+			Signer:     key,
+			HMACSecret: testrand.BytesInt(32),
+		}
+		signee := &signing.PublicKey{
+			Self: external.Self,
+			Key:  key.Public(),
+		}
+
+		signed, err := signing.SignOrderLimit(ctx, external, &pb.OrderLimit{
+			SerialNumber:    testrand.SerialNumber(),
+			SatelliteId:     external.Self,
+			StorageNodeId:   testrand.NodeID(),
+			PieceId:         testrand.PieceID(),
+			Limit:           1024,
+			Action:          pb.PieceAction_GET,
+			OrderCreation:   time.Now(),
+			OrderExpiration: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.NoError(t, signing.VerifyOrderLimitSignature(ctx, signee, signed))
+		require.NoError(t, signing.VerifyOrderLimitSignature(ctx, external, signed))
+
+		// signatures are compatible with the ones made by an in-memory key.
+		private := &signing.PrivateKey{Self: external.Self, Key: key}
+		data := testrand.BytesInt(100)
+		signature, err := private.HashAndSign(ctx, data)
+		require.NoError(t, err)
+		require.NoError(t, external.HashAndVerifySignature(ctx, data, signature))
+		signature, err = external.HashAndSign(ctx, data)
+		require.NoError(t, err)
+		require.NoError(t, private.HashAndVerifySignature(ctx, data, signature))
+
+		mac, err := external.SignHMACSHA256(ctx, data)
+		require.NoError(t, err)
+		require.NoError(t, external.VerifyHMACSHA256(ctx, data, mac))
+		require.Error(t, external.VerifyHMACSHA256(ctx, testrand.BytesInt(100), mac))
+	}
+
+	_, err = (&signing.ExternalKey{Signer: ecdsaKey}).SignHMACSHA256(ctx, []byte("data"))
+	require.Error(t, err)
+}
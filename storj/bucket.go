@@ -38,4 +38,6 @@ type Bucket struct {
 	DefaultRedundancyScheme     RedundancyScheme
 	DefaultEncryptionParameters EncryptionParameters
 	Placement                   PlacementConstraint
+	Versioning                  Versioning
+	ObjectLock                  ObjectLock
 }
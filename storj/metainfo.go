@@ -3,6 +3,8 @@
 
 package storj
 
+import "time"
+
 // ListDirection specifies listing direction.
 type ListDirection int8
 
@@ -26,6 +28,24 @@ type ListOptions struct {
 	Direction ListDirection
 	Limit     int
 	Status    int32
+
+	// CreatedAfter and CreatedBefore filter listed objects by their creation time,
+	// when they are not zero. Both bounds are exclusive, objects created exactly
+	// at CreatedAfter or CreatedBefore are not listed.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// CreatedInRange returns whether an object created at the specified time
+// matches the creation time filter. Both bounds are exclusive.
+func (opts ListOptions) CreatedInRange(created time.Time) bool {
+	if !opts.CreatedAfter.IsZero() && !created.After(opts.CreatedAfter) {
+		return false
+	}
+	if !opts.CreatedBefore.IsZero() && !created.Before(opts.CreatedBefore) {
+		return false
+	}
+	return true
 }
 
 // ObjectList is a list of objects.
@@ -52,6 +72,9 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 		Recursive: opts.Recursive,
 		Direction: After,
 		Limit:     opts.Limit,
+
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
 	}
 }
 
@@ -5,6 +5,7 @@ package storj_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,6 +20,8 @@ func TestListOptions(t *testing.T) {
 		Recursive: true,
 		Direction: storj.After,
 		Limit:     30,
+
+		CreatedAfter: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 	}
 
 	list := storj.ObjectList{
@@ -38,5 +41,28 @@ func TestListOptions(t *testing.T) {
 		Recursive: true,
 		Direction: storj.After,
 		Limit:     30,
+
+		CreatedAfter: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 	}, newopts)
 }
+
+func TestListOptions_CreatedInRange(t *testing.T) {
+	after := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	inside := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	require.True(t, storj.ListOptions{}.CreatedInRange(inside))
+
+	opts := storj.ListOptions{CreatedAfter: after}
+	require.True(t, opts.CreatedInRange(inside))
+	require.False(t, opts.CreatedInRange(after))
+
+	opts = storj.ListOptions{CreatedBefore: before}
+	require.True(t, opts.CreatedInRange(inside))
+	require.False(t, opts.CreatedInRange(before))
+
+	opts = storj.ListOptions{CreatedAfter: after, CreatedBefore: before}
+	require.True(t, opts.CreatedInRange(inside))
+	require.False(t, opts.CreatedInRange(after.Add(-time.Hour)))
+	require.False(t, opts.CreatedInRange(before.Add(time.Hour)))
+}
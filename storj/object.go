@@ -15,6 +15,9 @@ var (
 
 	// ErrObjectNotFound is an error class for non-existing object.
 	ErrObjectNotFound = errs.Class("object not found")
+
+	// ErrObjectLocked is an error class for deleting or overwriting an object protected by object lock.
+	ErrObjectLocked = errs.Class("object is locked")
 )
 
 // Object contains information about a specific object.
@@ -30,6 +33,8 @@ type Object struct {
 	Created     time.Time
 	Modified    time.Time
 	Expires     time.Time
+	// RetainUntil is set when the object is protected by object lock.
+	RetainUntil time.Time
 
 	Stream
 }
@@ -49,6 +54,8 @@ type ObjectInfo struct {
 	Created     time.Time
 	Modified    time.Time
 	Expires     time.Time
+	// RetainUntil is set when the object is protected by object lock.
+	RetainUntil time.Time
 
 	Stream
 }
@@ -0,0 +1,31 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+import (
+	"time"
+)
+
+// ObjectLock contains the default object lock configuration of a bucket.
+type ObjectLock struct {
+	// Enabled makes objects in the bucket immutable until their retention period expires.
+	Enabled bool
+	// DefaultRetentionDays is the retention period for new objects in days.
+	DefaultRetentionDays int
+}
+
+// RetainUntil returns until when an object created at the specified time is locked.
+// It returns zero time when object lock is not enabled.
+func (lock ObjectLock) RetainUntil(created time.Time) time.Time {
+	if !lock.Enabled || lock.DefaultRetentionDays <= 0 {
+		return time.Time{}
+	}
+	return created.AddDate(0, 0, lock.DefaultRetentionDays)
+}
+
+// IsLocked returns whether an object created at the specified time is still locked at now.
+func (lock ObjectLock) IsLocked(created, now time.Time) bool {
+	retainUntil := lock.RetainUntil(created)
+	return !retainUntil.IsZero() && now.Before(retainUntil)
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/common/storj"
+)
+
+func TestObjectLock(t *testing.T) {
+	created := time.Date(2022, 1, 30, 12, 0, 0, 0, time.UTC)
+
+	disabled := storj.ObjectLock{DefaultRetentionDays: 10}
+	assert.True(t, disabled.RetainUntil(created).IsZero())
+	assert.False(t, disabled.IsLocked(created, created))
+
+	noRetention := storj.ObjectLock{Enabled: true}
+	assert.True(t, noRetention.RetainUntil(created).IsZero())
+	assert.False(t, noRetention.IsLocked(created, created))
+
+	lock := storj.ObjectLock{Enabled: true, DefaultRetentionDays: 10}
+	retainUntil := time.Date(2022, 2, 9, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, retainUntil, lock.RetainUntil(created))
+	assert.True(t, lock.IsLocked(created, created))
+	assert.True(t, lock.IsLocked(created, retainUntil.Add(-time.Nanosecond)))
+	assert.False(t, lock.IsLocked(created, retainUntil))
+	assert.False(t, lock.IsLocked(created, retainUntil.Add(time.Hour)))
+}
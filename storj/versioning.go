@@ -0,0 +1,38 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+// Versioning is the object versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned buckets overwrite objects on re-upload.
+	Unversioned Versioning = 0
+
+	// VersioningEnabled buckets keep prior versions when an object is overwritten.
+	VersioningEnabled Versioning = 1
+
+	// VersioningSuspended buckets keep already existing versions,
+	// but new uploads overwrite the latest version.
+	VersioningSuspended Versioning = 2
+)
+
+// String returns a string representation of the versioning state.
+func (v Versioning) String() string {
+	switch v {
+	case Unversioned:
+		return "Unversioned"
+	case VersioningEnabled:
+		return "Enabled"
+	case VersioningSuspended:
+		return "Suspended"
+	default:
+		return "Invalid"
+	}
+}
+
+// KeepsVersions returns whether overwriting an object preserves its prior version.
+func (v Versioning) KeepsVersions() bool {
+	return v == VersioningEnabled
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+func TestVersioning(t *testing.T) {
+	assert.Equal(t, "Unversioned", storj.Unversioned.String())
+	assert.Equal(t, "Enabled", storj.VersioningEnabled.String())
+	assert.Equal(t, "Suspended", storj.VersioningSuspended.String())
+	assert.Equal(t, "Invalid", storj.Versioning(42).String())
+
+	assert.False(t, storj.Unversioned.KeepsVersions())
+	assert.True(t, storj.VersioningEnabled.KeepsVersions())
+	assert.False(t, storj.VersioningSuspended.KeepsVersions())
+
+	// the values must match the protocol definition.
+	assert.EqualValues(t, pb.Versioning_UNVERSIONED, storj.Unversioned)
+	assert.EqualValues(t, pb.Versioning_ENABLED, storj.VersioningEnabled)
+	assert.EqualValues(t, pb.Versioning_SUSPENDED, storj.VersioningSuspended)
+}